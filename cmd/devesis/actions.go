@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
 	"strconv"
 	"strings"
@@ -8,146 +9,202 @@ import (
 	"github.com/spaceship/devesis/pkg/core"
 )
 
-// consumeAction decrements the action counter and returns true if action is allowed
-func (g *GameManager) consumeAction() bool {
-	if g.state.ActionsLeft <= 0 {
-		fmt.Println("✗ No actions remaining this turn!")
-		return false
-	}
-	g.state.ActionsLeft--
-	return true
-}
-
-func (g *GameManager) executeMove(args []string) error {
+func (g *GameManager) executeMove(args []string, reader *bufio.Reader) error {
 	if len(args) == 0 {
 		return fmt.Errorf("usage: move <roomID>")
 	}
 	
-	targetRoom := strings.ToUpper(args[0])
+	targetRoom := core.RoomID(strings.ToUpper(args[0]))
 	player := core.GetActivePlayer(g.state)
 	if player == nil {
 		return fmt.Errorf("no active player")
 	}
 	
-	// Check basic movement validity first - don't consume action if invalid
-	if !core.CanMove(g.state, player.Location, core.RoomID(targetRoom)) {
-		fmt.Printf("✗ Cannot move to %s (not adjacent).\n", targetRoom)
-		return nil
+	// Reject illegal moves before asking anything - no action is consumed
+	move := core.MoveAction{PlayerID: player.ID, To: targetRoom}
+	if err := core.ValidateAction(g.state, move); err != nil {
+		return err
 	}
 	
-	if !g.consumeAction() {
+	// Explain any question or corruption toll and get confirmation
+	if !g.PreviewAndConfirm(move, reader) {
+		fmt.Println("Movement cancelled.")
 		return nil
 	}
 	
-	// Check if target room is already explored - no question needed
-	targetRoomState := g.state.Rooms[core.RoomID(targetRoom)]
-	if targetRoomState != nil && targetRoomState.Explored {
-		// Skip question for explored rooms
-		fmt.Printf("Moving to explored room %s (no question needed).\n", targetRoom)
+	if err := g.ResolveWithLogging(move); err != nil {
+		return err
+	}
+	if g.state.PendingQuestion != nil {
+		if err := g.answerPendingQuestion(reader); err != nil {
+			return err
+		}
+	}
+	
+	// Show what the room turned out to be once the player is inside
+	if core.GetActivePlayer(g.state).Location == targetRoom {
+		fmt.Printf("📍 You discover this is a %s.\n", g.getRoomTypeName(g.state.Rooms[targetRoom]))
+	}
+	
+	return nil
+}
+
+// answerPendingQuestion asks the pending coding question, applies the answer
+// and reports the outcome. It re-prompts until the answer fits the question.
+func (g *GameManager) answerPendingQuestion(reader *bufio.Reader) error {
+	question, _ := core.CurrentQuestion(g.state)
+	pending := *g.state.PendingQuestion
+	
+	if pending.Corrupted {
+		fmt.Printf("\n[CODING CHALLENGE] Answer correctly to get through the corruption into %s:\n", pending.To)
 	} else {
-		// Warn about unexplored room and get confirmation
-		fmt.Printf("⚠️  Warning: %s is unexplored! You'll need to answer a coding question.\n", targetRoom)
-		fmt.Printf("Wrong answers cause bugs to spread and you lose all cards!\n")
-		fmt.Print("Continue? (y/n): ")
-		
-		var confirm string
-		fmt.Scanf("%s", &confirm)
-		if confirm != "y" && confirm != "Y" && confirm != "yes" && confirm != "Yes" {
-			fmt.Println("Movement cancelled.")
-			return nil
+		fmt.Printf("\n[CODING CHALLENGE] Answer correctly to move to %s:\n", pending.To)
+	}
+	fmt.Printf("(%s, %s)\n", question.Category, question.Difficulty)
+	fmt.Printf("%s\n\n", question.Text)
+	if question.Code != "" {
+		printCodeSnippet(question.Code)
+		fmt.Println()
+	}
+	for i, option := range question.Options {
+		fmt.Printf("%d) %s\n", i+1, option)
+	}
+	skips := g.state.Players[pending.PlayerID].QuestionSkips
+	if skips > 0 {
+		fmt.Printf("\n⏭️ You have %d question skip(s) - type 'skip' to spend one and move in without answering.\n", skips)
+	}
+	
+	var answer core.AnswerQuestionAction
+	for {
+		switch question.Kind {
+		case core.MultiQuestion:
+			fmt.Print("Pick all that apply (e.g. 1,3): ")
+		case core.TextQuestion:
+			fmt.Print("Your answer: ")
+		default:
+			fmt.Printf("Answer (1-%d): ", len(question.Options))
 		}
-		
-		// Get a coding question before allowing movement to unexplored rooms
-		question, questionState := core.GetRandomQuestion(*g.state)
-		if question.ID == -1 {
-			// No questions available, allow movement without question
-			fmt.Println("⚠ No questions available, movement allowed.")
-		} else {
-		// Show the question
-		fmt.Printf("\n[CODING CHALLENGE] Answer correctly to move to %s:\n", targetRoom)
-		fmt.Printf("%s\n\n", question.Text)
-		for i, option := range question.Options {
-			fmt.Printf("%d) %s\n", i+1, option)
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("error reading input: %w", err)
 		}
-		
-		fmt.Print("Answer (1-4): ")
-		var choice int
-		_, err := fmt.Scanf("%d", &choice)
-		if err != nil || choice < 1 || choice > 4 {
-			fmt.Println("✗ Invalid answer format. Movement failed.")
-			return nil
+		if skips > 0 && strings.EqualFold(strings.TrimSpace(input), "skip") {
+			return g.skipPendingQuestion(pending)
 		}
 		
-		// Check if answer is correct
-		if core.CheckAnswer(question, choice-1) {
-			fmt.Println("✓ Correct! You may proceed.")
-			// Reward: Give a special card for correct answer using reducer
-			rewardAction := core.GiveSpecialCardAction{
-				PlayerID: core.GetActivePlayer(g.state).ID,
-			}
-			newState := core.ApplyWithoutLog(*g.state, rewardAction)
-			
-			// Check if a card was actually added
-			oldPlayer := core.GetActivePlayer(g.state)
-			newPlayer := core.GetActivePlayer(&newState)
-			if len(newPlayer.Hand) > len(oldPlayer.Hand) {
-				// Card was added, show reward message
-				addedCard := newPlayer.Hand[len(newPlayer.Hand)-1]
-				if card, exists := core.CardDB[addedCard]; exists {
-					fmt.Printf("🎁 Reward: **%s** - %s\n", card.Name, card.Description)
-				} else {
-					fmt.Printf("🎁 Reward: Special card added to hand!\n")
-				}
-			}
-			g.state = &newState
-		} else {
-			fmt.Println("✗ Incorrect answer! Bugs spread everywhere...")
-			// Update state to mark question as used
-			g.state = &questionState
-			// Apply severe penalties for wrong answer
-			return g.applyWrongAnswerPenalties(core.RoomID(targetRoom))
+		var parseErr error
+		answer, parseErr = parseQuestionAnswer(question, pending.PlayerID, input)
+		if parseErr == nil {
+			parseErr = core.ValidateAction(g.state, answer)
 		}
-		// Update state to mark question as used for correct answers
-		g.state = &questionState
+		if parseErr == nil {
+			break
 		}
+		fmt.Printf("✗ Invalid answer: %v\n", parseErr)
 	}
 	
-	// Apply the movement action with logging
-	action := core.MoveAction{
-		PlayerID: player.ID,
-		To:       core.RoomID(targetRoom),
+	cardCount := len(g.state.Players[pending.PlayerID].Hand)
+	log := core.NewEffectLog()
+	newState, err := core.ApplyChecked(*g.state, answer, log)
+	if err != nil {
+		return err
 	}
+	g.state = &newState
 	
-	g.ResolveWithLogging(action)
+	// The move already paid for the action; answering is free
+	g.record(func(j *core.Journal) error { return j.RecordAction(answer, 0, g.state) })
 	
-	// Check if move succeeded (effects already shown via ResolveWithLogging)
-	newPlayer := core.GetActivePlayer(g.state)
-	if newPlayer.Location == core.RoomID(targetRoom) {
-		// Show room type discovery if it's newly explored
-		if room := g.state.Rooms[core.RoomID(targetRoom)]; room != nil && room.Explored {
-			fmt.Printf("📍 You discover this is a %s.\n", g.getRoomTypeName(room))
+	if core.CheckResponse(question, answer) {
+		fmt.Println("✓ Correct! You may proceed.")
+		if question.Difficulty == core.Hard && !pending.Corrupted {
+			fmt.Println("🏆 Hard question solved - you earn a rare special card!")
+		}
+	} else if pending.Corrupted {
+		fmt.Printf("✗ Incorrect answer! You stay out of %s.\n", pending.To)
+		if question.Kind == core.TextQuestion {
+			fmt.Printf("   Expected: %s\n", question.Accepted[0])
 		}
 	} else {
-		fmt.Printf("✗ Movement to %s failed.\n", targetRoom)
+		fmt.Println("✗ Incorrect answer! Bugs spread everywhere...")
+		if question.Kind == core.TextQuestion {
+			fmt.Printf("   Expected: %s\n", question.Accepted[0])
+		}
+		g.showWrongAnswerPenalties(pending.To, question.Difficulty, cardCount)
+	}
+	if question.Explanation != "" {
+		fmt.Printf("💡 %s\n", question.Explanation)
 	}
 	
+	if !log.IsEmpty() {
+		fmt.Println("\n— Resolve —")
+		log.StreamLines(g.effectDelay)
+	}
 	return nil
 }
 
-func (g *GameManager) applyWrongAnswerPenalties(targetRoom core.RoomID) error {
-	// 1. Allow movement first
-	action := core.MoveAction{
-		PlayerID: core.GetActivePlayer(g.state).ID,
-		To:       targetRoom,
+// skipPendingQuestion spends a question skip on the pending move
+func (g *GameManager) skipPendingQuestion(pending core.PendingQuestion) error {
+	skip := core.SkipQuestionAction{PlayerID: pending.PlayerID}
+	log := core.NewEffectLog()
+	newState, err := core.ApplyChecked(*g.state, skip, log)
+	if err != nil {
+		return err
 	}
-	newState := core.ApplyWithoutLog(*g.state, action)
 	g.state = &newState
+	
+	// Like answering, skipping is part of the move that already paid
+	g.record(func(j *core.Journal) error { return j.RecordAction(skip, 0, g.state) })
+	
+	fmt.Printf("⏭️ Question skipped - you move to %s.\n", pending.To)
+	if !log.IsEmpty() {
+		fmt.Println("\n— Resolve —")
+		log.StreamLines(g.effectDelay)
+	}
+	return nil
+}
+
+// parseQuestionAnswer turns typed input into an answer for the question's
+// kind: one option number, a comma- or space-separated list of option
+// numbers, or free text
+func parseQuestionAnswer(question core.Question, playerID core.PlayerID, input string) (core.AnswerQuestionAction, error) {
+	answer := core.AnswerQuestionAction{PlayerID: playerID}
+	input = strings.TrimSpace(input)
+	
+	switch question.Kind {
+	case core.TextQuestion:
+		answer.Text = input
+	case core.MultiQuestion:
+		fields := strings.FieldsFunc(input, func(r rune) bool { return r == ',' || r == ' ' })
+		for _, field := range fields {
+			number, err := strconv.Atoi(field)
+			if err != nil {
+				return answer, fmt.Errorf("%q is not an option number", field)
+			}
+			answer.Choices = append(answer.Choices, number-1)
+		}
+	default:
+		number, err := strconv.Atoi(input)
+		if err != nil {
+			return answer, fmt.Errorf("enter an option number")
+		}
+		answer.Choice = number - 1
+	}
+	return answer, nil
+}
+
+// printCodeSnippet prints a question's code block indented, with tabs
+// expanded so it lines up in any terminal
+func printCodeSnippet(code string) {
+	for _, line := range strings.Split(code, "\n") {
+		fmt.Printf("    │ %s\n", strings.ReplaceAll(line, "\t", "    "))
+	}
+}
+
+// showWrongAnswerPenalties explains the penalties a wrong answer applied
+func (g *GameManager) showWrongAnswerPenalties(targetRoom core.RoomID, difficulty core.Difficulty, cardCount int) {
 	fmt.Printf("✓ You move to %s.\n", targetRoom)
 	
-	// 2. Show what rooms will be affected
-	roomsToInfect := []core.RoomID{targetRoom}
-	adjacent := core.GetAdjacentRooms(targetRoom)
-	roomsToInfect = append(roomsToInfect, adjacent...)
+	roomsToInfect := core.WrongAnswerBugRooms(targetRoom, difficulty)
 	
 	fmt.Printf("💀 Bugs spread to %d rooms: ", len(roomsToInfect))
 	for i, roomID := range roomsToInfect {
@@ -158,34 +215,22 @@ func (g *GameManager) applyWrongAnswerPenalties(targetRoom core.RoomID) error {
 	}
 	fmt.Println()
 	
-	// Count cards before penalty
-	player := core.GetActivePlayer(g.state)
-	cardCount := len(player.Hand)
-	
-	// 3. Apply all penalties using core function (bugs, spawns, card drop)
-	core.ApplyWrongAnswerPenalties(g.state, targetRoom)
-	
-	// 4. Show feedback
 	if cardCount > 0 {
 		fmt.Printf("💸 You drop all %d cards from your hand!\n", cardCount)
 	}
-	
-	fmt.Println("⏰ Your turn ends immediately due to the wrong answer.")
-	
-	return nil
 }
 
 func (g *GameManager) getRoomTypeName(room *core.RoomState) string {
 	// Handle predefined rooms first
 	if room.Type == core.Predefined {
-		switch room.ID {
-		case "R01":
+		switch core.RoleOf(room.ID) {
+		case core.KeyRole:
 			return "key room"
-		case "R12":
+		case core.StartRole:
 			return "start room"
-		case "R15", "R17", "R18":
+		case core.EngineRole:
 			return "engine room"
-		case "R19", "R20":
+		case core.EscapeRole:
 			return "escape room"
 		default:
 			return "special room"
@@ -210,43 +255,32 @@ func (g *GameManager) getRoomTypeName(room *core.RoomState) string {
 }
 
 func (g *GameManager) getEnemyName(enemyType core.EnemyType) string {
-	switch enemyType {
-	case core.InfiniteLoop:
-		return "Infinite Loop"
-	case core.StackOverflow:
-		return "Stack Overflow"
-	case core.Pythogoras:
-		return "Pythogoras"
-	default:
-		return "Unknown Enemy"
+	if def, exists := core.EnemyDB[enemyType]; exists {
+		return def.Name
 	}
+	return "Unknown Enemy"
 }
 
-func (g *GameManager) executeSearch() error {
+func (g *GameManager) executeOpenDoor(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: open <roomID>")
+	}
+	
 	player := core.GetActivePlayer(g.state)
 	if player == nil {
 		return fmt.Errorf("no active player")
 	}
 	
-	// Check if room was already searched - don't consume action if invalid
-	currentRoom := g.state.Rooms[player.Location]
-	if currentRoom != nil && currentRoom.Searched {
-		fmt.Println("❌ This room has already been searched.")
-		return nil
-	}
-	
-	if !g.consumeAction() {
-		return nil
-	}
-	
-	// Execute search action with logging
-	action := core.SearchAction{
-		PlayerID: player.ID,
+	return g.ResolveWithLogging(core.OpenDoorAction{PlayerID: player.ID, To: core.RoomID(strings.ToUpper(args[0]))})
+}
+
+func (g *GameManager) executeSearch() error {
+	player := core.GetActivePlayer(g.state)
+	if player == nil {
+		return fmt.Errorf("no active player")
 	}
 	
-	g.ResolveWithLogging(action)
-	
-	return nil
+	return g.ResolveWithLogging(core.SearchAction{PlayerID: player.ID})
 }
 
 func (g *GameManager) executeShoot() error {
@@ -255,42 +289,7 @@ func (g *GameManager) executeShoot() error {
 		return fmt.Errorf("no active player")
 	}
 	
-	// Check ammo before attempting - don't consume action if insufficient
-	if player.Ammo < core.ShootAmmoCost {
-		fmt.Printf("✗ Not enough ammo! Need %d ammo, have %d.\n", core.ShootAmmoCost, player.Ammo)
-		return nil
-	}
-	
-	// Get adjacent rooms and count targets - don't consume action if no targets
-	adjacentRooms := core.GetAdjacentRooms(player.Location)
-	targets := make(map[core.RoomID][]core.EnemyID)
-	totalTargets := 0
-	
-	for enemyID, enemy := range g.state.Enemies {
-		for _, roomID := range adjacentRooms {
-			if enemy.Location == roomID {
-				targets[roomID] = append(targets[roomID], enemyID)
-				totalTargets++
-				break
-			}
-		}
-	}
-	
-	if totalTargets == 0 {
-		fmt.Println("✗ No enemies in adjacent rooms to shoot!")
-		return nil
-	}
-	
-	if !g.consumeAction() {
-		return nil
-	}
-	
-	action := core.ShootAction{
-		PlayerID: player.ID,
-	}
-	
-	g.ResolveWithLogging(action)
-	return nil
+	return g.ResolveWithLogging(core.ShootAction{PlayerID: player.ID})
 }
 
 func (g *GameManager) executeMelee() error {
@@ -299,34 +298,12 @@ func (g *GameManager) executeMelee() error {
 		return fmt.Errorf("no active player")
 	}
 	
-	// Count targets in current room - don't consume action if no targets
-	targets := make([]core.EnemyID, 0)
-	for enemyID, enemy := range g.state.Enemies {
-		if enemy.Location == player.Location {
-			targets = append(targets, enemyID)
-		}
-	}
-	
-	if len(targets) == 0 {
-		fmt.Println("✗ No enemies in current room to attack!")
-		return nil
-	}
-	
-	if !g.consumeAction() {
-		return nil
-	}
-	
-	action := core.MeleeAction{
-		PlayerID: player.ID,
-	}
-	
-	g.ResolveWithLogging(action)
-	return nil
+	return g.ResolveWithLogging(core.MeleeAction{PlayerID: player.ID})
 }
 
-func (g *GameManager) executePlayCard(args []string) error {
+func (g *GameManager) executePlayCard(args []string, reader *bufio.Reader) error {
 	if len(args) == 0 {
-		return fmt.Errorf("usage: play <cardNumber> or play <cardID>")
+		return fmt.Errorf("usage: play <cardNumber> or play <cardID> [roomID]")
 	}
 	
 	player := core.GetActivePlayer(g.state)
@@ -334,15 +311,14 @@ func (g *GameManager) executePlayCard(args []string) error {
 		return fmt.Errorf("no active player")
 	}
 	
-	if len(player.Hand) == 0 {
-		fmt.Println("✗ Your hand is empty!")
-		return nil
-	}
-	
 	var cardID core.CardID
 	
 	// Try to parse as card number first (1-based index)
 	if cardNum, err := strconv.Atoi(args[0]); err == nil {
+		if len(player.Hand) == 0 {
+			fmt.Println("✗ Your hand is empty!")
+			return nil
+		}
 		if cardNum < 1 || cardNum > len(player.Hand) {
 			fmt.Printf("✗ Card number must be between 1 and %d!\n", len(player.Hand))
 			return nil
@@ -354,39 +330,42 @@ func (g *GameManager) executePlayCard(args []string) error {
 		cardID = core.CardID(args[0])
 	}
 	
-	// Check if card is in hand
-	found := false
-	for _, handCardID := range player.Hand {
-		if handCardID == cardID {
-			found = true
-			break
-		}
+	action := core.PlayCardAction{
+		PlayerID: player.ID,
+		CardID:   cardID,
 	}
 	
-	if !found {
-		fmt.Printf("✗ Card %s not in your hand!\n", cardID)
-		return nil
+	// Movement cards need a destination room
+	steps := core.SprintSteps(core.CardDB[cardID])
+	if len(args) > 1 {
+		action.Target = core.RoomID(strings.ToUpper(args[1]))
+	} else if steps > 0 {
+		if targets := core.SprintTargets(g.state, player.ID, steps); len(targets) > 0 {
+			fmt.Printf("Rooms within reach: %s\n", joinRoomIDs(targets, ", "))
+		}
+		return fmt.Errorf("usage: play %s <roomID>", args[0])
 	}
-	
-	// Get card details for feedback
-	card, err := core.GetCard(cardID)
-	if err != nil {
-		fmt.Printf("✗ Unknown card: %s\n", cardID)
-		return nil
+	if err := core.ValidateAction(g.state, action); err != nil {
+		return err
 	}
 	
-	if !g.consumeAction() {
+	// Show the path before sprinting
+	if steps > 0 && !g.PreviewAndConfirm(action, reader) {
+		fmt.Println("Sprint cancelled.")
 		return nil
 	}
 	
-	// Execute play card action with logging
-	action := core.PlayCardAction{
-		PlayerID: player.ID,
-		CardID:   cardID,
+	fmt.Printf("✓ Playing %s\n", core.CardDB[cardID].Name)
+	if err := g.ResolveWithLogging(action); err != nil {
+		return err
 	}
 	
-	fmt.Printf("✓ Playing %s\n", card.Name)
-	g.ResolveWithLogging(action)
+	// A sprint stops for the question of every unexplored room on the way
+	for g.state.PendingQuestion != nil {
+		if err := g.answerPendingQuestion(reader); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -396,39 +375,10 @@ func (g *GameManager) executeRoomAction() error {
 		return fmt.Errorf("no active player")
 	}
 	
-	// Basic validation before consuming action
-	if player.SpecialUsed {
-		fmt.Println("✗ Room action already used this turn!")
-		return nil
-	}
-	
-	// Check if current room has a special ability
-	room := g.state.Rooms[player.Location]
-	if room == nil {
-		fmt.Println("✗ No room found at current location!")
-		return nil
-	}
-	
-	// Check if room type supports actions
-	if room.Type != core.MedBay && room.Type != core.AmmoCache && room.Type != core.CleanRoomType {
-		fmt.Println("✗ No special room action available here")
-		return nil
-	}
-	
-	if !g.consumeAction() {
-		return nil
-	}
-	
 	// Execute room action through core reducer with logging
-	action := core.RoomAction{
-		PlayerID: player.ID,
-	}
-	
-	g.ResolveWithLogging(action)
-	return nil
+	return g.ResolveWithLogging(core.RoomAction{PlayerID: player.ID})
 }
 
-
 func (g *GameManager) executePass() error {
 	player := core.GetActivePlayer(g.state)
 	if player == nil {
@@ -437,8 +387,13 @@ func (g *GameManager) executePass() error {
 	
 	// Pass ends the player phase immediately
 	actionsSkipped := g.state.ActionsLeft
-	g.state.ActionsLeft = 0
+	newState, err := core.ApplyChecked(*g.state, core.PassAction{PlayerID: player.ID}, core.NewEffectLog())
+	if err != nil {
+		return err
+	}
+	g.state = &newState
+	g.record(func(j *core.Journal) error { return j.RecordAction(core.PassAction{PlayerID: player.ID}, 0, g.state) })
 	fmt.Printf("You pass your turn. (%d actions skipped)\n", actionsSkipped)
 	
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spaceship/devesis/pkg/core"
+)
+
+// dailyBoardSize is how many runs are shown after a daily challenge
+const dailyBoardSize = 10
+
+// LeaderboardEntry is one finished daily challenge run
+type LeaderboardEntry struct {
+	Date      string    `json:"date"`
+	Class     string    `json:"class"`
+	Seed      int64     `json:"seed"`
+	Name      string    `json:"name"`
+	Timestamp time.Time `json:"timestamp"`
+	core.RunSummary
+}
+
+// dailyDate returns today's challenge date. UTC keeps the seed shared across time zones.
+func dailyDate() string {
+	return time.Now().UTC().Format("2006-01-02")
+}
+
+// defaultPlayerName labels leaderboard entries when --name is not given
+func defaultPlayerName() string {
+	if name := os.Getenv("USER"); name != "" {
+		return name
+	}
+	if name := os.Getenv("USERNAME"); name != "" {
+		return name
+	}
+	return "anonymous"
+}
+
+// leaderboardPath returns the leaderboard file under the user's config directory
+func leaderboardPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	return filepath.Join(configDir, "devesis", "leaderboard.json"), nil
+}
+
+// readLeaderboard loads every recorded daily run; a missing file is an empty board
+func readLeaderboard() ([]LeaderboardEntry, error) {
+	path, err := leaderboardPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read leaderboard: %w", err)
+	}
+
+	var entries []LeaderboardEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode leaderboard: %w", err)
+	}
+	return entries, nil
+}
+
+// appendLeaderboard adds a run to the leaderboard file
+func appendLeaderboard(entry LeaderboardEntry) error {
+	entries, err := readLeaderboard()
+	if err != nil {
+		return err
+	}
+	entries = append(entries, entry)
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode leaderboard: %w", err)
+	}
+
+	path, err := leaderboardPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	// Write to a temp file first so a crash mid-write never loses earlier runs
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write leaderboard: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to write leaderboard: %w", err)
+	}
+	return nil
+}
+
+// validateDailyOptions rejects flags that would break equal footing between daily runs
+func validateDailyOptions(opts InitOptions) error {
+	switch {
+	case opts.ResumeSlot != "":
+		return errors.New("--resume cannot be used with the daily challenge")
+	case opts.Seed != 0:
+		return errors.New("--seed cannot be used with the daily challenge; the seed comes from the date and class")
+	case opts.Players > 1 || len(opts.Classes) > 1:
+		return errors.New("the daily challenge is played solo")
+	case opts.Difficulty != core.Normal:
+		return errors.New("the daily challenge is always played on normal difficulty")
+	case opts.MapName != "" && opts.MapName != core.DefaultMapName:
+		return errors.New("the daily challenge is always played on the default map")
+	}
+	return nil
+}
+
+// finishDaily records a daily challenge result and shows the day's top runs
+func (g *GameManager) finishDaily(win bool) {
+	if g.dailyDate == "" {
+		return
+	}
+
+	var player *core.PlayerState
+	for _, p := range g.state.Players {
+		player = p
+	}
+	entry := LeaderboardEntry{
+		Date:       g.dailyDate,
+		Class:      classDisplayName(player.Class),
+		Seed:       g.state.RandSeed,
+		Name:       g.playerName,
+		Timestamp:  time.Now(),
+		RunSummary: core.SummarizeRun(g.state, win),
+	}
+
+	if err := appendLeaderboard(entry); err != nil {
+		fmt.Printf("⚠️ Could not record daily result: %v\n", err)
+		return
+	}
+
+	entries, err := readLeaderboard()
+	if err != nil {
+		fmt.Printf("⚠️ Could not read leaderboard: %v\n", err)
+		return
+	}
+	g.displayLeaderboard(entries, entry)
+}
+
+// displayLeaderboard prints the best runs for the entry's date and class, marking the entry itself
+func (g *GameManager) displayLeaderboard(entries []LeaderboardEntry, current LeaderboardEntry) {
+	var board []LeaderboardEntry
+	for _, e := range entries {
+		if e.Date == current.Date && e.Class == current.Class {
+			board = append(board, e)
+		}
+	}
+	sort.SliceStable(board, func(i, j int) bool {
+		if board[i].RunSummary != board[j].RunSummary {
+			return core.BetterRun(board[i].RunSummary, board[j].RunSummary)
+		}
+		return board[i].Timestamp.Before(board[j].Timestamp) // Earlier runs win ties
+	})
+
+	fmt.Printf("\n🏆 Daily challenge %s - %s (%d run(s))\n", current.Date, current.Class, len(board))
+	fmt.Printf("   %-4s %-16s %-7s %6s %4s %5s %9s\n", "#", "Name", "Result", "Rounds", "HP", "Kills", "Questions")
+	for i, e := range board {
+		isCurrent := e.Timestamp.Equal(current.Timestamp) && e.Name == current.Name
+		if i >= dailyBoardSize && !isCurrent {
+			continue
+		}
+		marker := "  "
+		if isCurrent {
+			marker = "➤ "
+		}
+		result := "lost"
+		if e.Win {
+			result = "won"
+		}
+		fmt.Printf("%s %-4d %-16s %-7s %6d %4d %5d %9d\n",
+			marker, i+1, e.Name, result, e.Rounds, e.HP, e.Kills, e.QuestionsAnswered)
+	}
+}
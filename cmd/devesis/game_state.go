@@ -2,7 +2,9 @@ package main
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -11,14 +13,42 @@ import (
 )
 
 type GameManager struct {
-	state *core.GameState
+	state       *core.GameState
+	journal     *core.Journal // nil when journaling is off
+	journalFile *os.File
+	effectDelay time.Duration // Pause between streamed effect lines
+	dataDir     string        // Data directory; maps of loaded saves come from here
+	dailyDate   string        // Daily challenge date; empty outside daily mode
+	playerName  string        // Name recorded on the daily leaderboard
+	playerNames []string      // --name entries for P1, P2, ...
+	
+	profileNames map[core.PlayerID]string // Learning profile of each developer; nil when profiles are off
+}
+
+// defaultEffectDelay keeps resolved effects readable line by line
+const defaultEffectDelay = 1000 * time.Millisecond
+
+// InitOptions controls how a new session starts
+type InitOptions struct {
+	ResumeSlot string          // Load this save slot instead of starting a new game
+	Seed       int64           // Game seed; 0 picks one from the clock
+	Players    int             // Number of developers; 0 asks at startup
+	Classes    []core.DevClass // Classes for P1, P2, ...; empty asks for each player
+	Difficulty core.Difficulty // Spawn bag difficulty; empty means normal
+	DataDir    string          // Directory holding cards.yaml, objectives.yaml, questions.yaml and maps/
+	MapName    string          // Map file in DataDir/maps without .yaml, or "random"; empty means the default map
+	NoDelay    bool            // Stream effects without pauses
+	DailyDate  string          // Play this date's daily challenge; the seed comes from the date and class
+	PlayerName string          // Name recorded on the daily leaderboard
+	PlayerNames []string       // Learning profile names for P1, P2, ...; missing ones derive from the first
+	NoProfile  bool            // Neither schedule questions from nor update learning profiles
 }
 
 func NewGameManager() *GameManager {
 	return &GameManager{}
 }
 
-func (g *GameManager) Initialize() error {
+func (g *GameManager) Initialize(opts InitOptions) error {
 	// ASCII Art Title
 	fmt.Print(`
 ████████▄     ▄████████  ▄█    █▄     ▄████████    ▄████████  ▄█     ▄████████ 
@@ -31,34 +61,185 @@ func (g *GameManager) Initialize() error {
 ████████▀    ██████████  ▀██████▀    ██████████  ▄████████▀  █▀    ▄████████▀
 `)
 	fmt.Println("Welcome to Devesis: Tutorial Hell!")
-	fmt.Println("Escape Tutorial Hell before your sanity.exe stops responding!\n")
+	fmt.Print("Escape Tutorial Hell before your sanity.exe stops responding!\n\n")
+	
+	g.effectDelay = defaultEffectDelay
+	if opts.NoDelay {
+		g.effectDelay = 0
+	}
+	
+	dataDir := opts.DataDir
+	if dataDir == "" {
+		dataDir = "./data"
+	}
+	g.dataDir = dataDir
 	
 	// Load card database
-	if err := core.LoadCards("./data"); err != nil {
+	if err := core.LoadCards(dataDir); err != nil {
 		return fmt.Errorf("failed to load cards: %w", err)
 	}
 	
-	// Get player class selection
-	playerClass, err := g.selectPlayerClass()
-	if err != nil {
-		return err
+	// Load enemy definitions; objectives refer to enemy types by key
+	if err := core.LoadEnemies(dataDir); err != nil {
+		return fmt.Errorf("failed to load enemies: %w", err)
+	}
+	
+	// Load objective catalogue
+	if err := core.LoadObjectives(dataDir); err != nil {
+		return fmt.Errorf("failed to load objectives: %w", err)
+	}
+	
+	// Load coding question bank and packs
+	if err := core.LoadQuestions(dataDir); err != nil {
+		return fmt.Errorf("failed to load questions: %w", err)
+	}
+	
+	// Resume a saved game - skips player and class selection
+	if opts.ResumeSlot != "" {
+		state, meta, err := readSaveSlot(opts.ResumeSlot)
+		if err != nil {
+			return fmt.Errorf("failed to resume: %w", err)
+		}
+		if err := loadGameMap(dataDir, state); err != nil {
+			return fmt.Errorf("failed to resume: %w", err)
+		}
+		g.state = state
+		if !opts.NoProfile {
+			g.assignProfiles(opts.PlayerNames)
+		}
+		fmt.Printf("Resuming slot '%s': round %d - %s\n", opts.ResumeSlot, meta.Round, formatSlotPlayers(meta.Players))
+		g.displaySeed()
+		fmt.Print("Type '?' for help\n\n")
+		return nil
+	}
+	
+	// Load the ship map new games are played on; a random map waits for the seed
+	if opts.MapName != core.RandomMapName {
+		if err := core.LoadMap(dataDir, opts.MapName); err != nil {
+			return fmt.Errorf("failed to load map: %w", err)
+		}
+	}
+	
+	// Get number of developers at this terminal (flags skip the prompt)
+	playerCount := opts.Players
+	if playerCount == 0 {
+		playerCount = len(opts.Classes)
+	}
+	if opts.DailyDate != "" {
+		playerCount = 1 // Daily runs are solo so results compare fairly
+	}
+	if playerCount == 0 {
+		var err error
+		if playerCount, err = g.selectPlayerCount(); err != nil {
+			return err
+		}
+	}
+	
+	// Get player class selection for each developer not covered by --class
+	playerClasses := make([]core.DevClass, 0, playerCount)
+	for i := 1; i <= playerCount; i++ {
+		if i <= len(opts.Classes) {
+			playerClasses = append(playerClasses, opts.Classes[i-1])
+			continue
+		}
+		if playerCount > 1 {
+			fmt.Printf("\n--- Player P%d ---\n", i)
+		}
+		playerClass, err := g.selectPlayerClass()
+		if err != nil {
+			return err
+		}
+		playerClasses = append(playerClasses, playerClass)
+	}
+	
+	seed := opts.Seed
+	if opts.DailyDate != "" {
+		seed = core.DailySeed(opts.DailyDate, playerClasses[0])
+		g.dailyDate = opts.DailyDate
+		g.playerName = opts.PlayerName
+		fmt.Printf("📅 Daily challenge %s - playing as %s\n", opts.DailyDate, g.playerName)
+	}
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	if opts.MapName == core.RandomMapName {
+		if err := core.LoadMap(dataDir, core.GeneratedMapName(seed, core.DefaultMapGenOptions)); err != nil {
+			return fmt.Errorf("failed to generate map: %w", err)
+		}
 	}
 	
 	// Create initial game state using reducer
 	emptyState := core.GameState{}
 	initialAction := core.InitializeGameAction{
-		Seed:        time.Now().UnixNano(),
-		PlayerClass: playerClass,
+		Seed:          seed,
+		Difficulty:    opts.Difficulty,
+		PlayerClasses: playerClasses,
 	}
 	
 	newState := core.ApplyWithoutLog(emptyState, initialAction)
 	g.state = &newState
 	
-	fmt.Printf("You are a %s developer. Good luck!\n", g.getClassDisplayName(playerClass))
-	fmt.Println("Type '?' for help\n")
+	// Learning profiles bring missed questions back first; the daily
+	// challenge keeps the seeded order so every run faces the same questions
+	if !opts.NoProfile {
+		g.assignProfiles(opts.PlayerNames)
+		if g.dailyDate == "" {
+			g.scheduleQuestions()
+		}
+	}
+	
+	if playerCount == 1 {
+		fmt.Printf("You are a %s developer. Good luck!\n", g.getClassDisplayName(playerClasses[0]))
+	} else {
+		fmt.Printf("\n%d developers enter Tutorial Hell. Good luck, team!\n", playerCount)
+		for i, class := range playerClasses {
+			fmt.Printf("  P%d: %s\n", i+1, g.getClassDisplayName(class))
+		}
+	}
+	g.displaySeed()
+	fmt.Print("Type '?' for help\n\n")
+	return nil
+}
+
+// displaySeed prints the seed, difficulty and map so a run can be replayed with --seed
+func (g *GameManager) displaySeed() {
+	difficulty := g.state.Difficulty
+	if difficulty == "" {
+		difficulty = core.Normal
+	}
+	mapFlag := ""
+	if g.state.Map != "" && g.state.Map != core.DefaultMapName {
+		mapFlag = " --map " + g.state.Map
+	}
+	fmt.Printf("🌱 Seed: %d (difficulty: %s) - share it with --seed %d --difficulty %s%s\n",
+		g.state.RandSeed, difficulty, g.state.RandSeed, difficulty, mapFlag)
+}
+
+// loadGameMap loads the map a saved or journaled game was played on; saves
+// from before maps were data files have none and use the default map
+func loadGameMap(dataDir string, state *core.GameState) error {
+	if err := core.LoadMap(dataDir, state.Map); err != nil {
+		return fmt.Errorf("failed to load map: %w", err)
+	}
 	return nil
 }
 
+func (g *GameManager) selectPlayerCount() (int, error) {
+	fmt.Printf("How many developers are playing at this terminal? (1-%d): ", core.MaxPlayers)
+	
+	var count int
+	_, err := fmt.Scanf("%d", &count)
+	if err != nil {
+		return 1, fmt.Errorf("invalid input: %v", err)
+	}
+	
+	if count < 1 || count > core.MaxPlayers {
+		fmt.Printf("Invalid player count %d, defaulting to solo play\n", count)
+		return 1, nil
+	}
+	return count, nil
+}
+
 func (g *GameManager) selectPlayerClass() (core.DevClass, error) {
 	classes := core.GetAvailableClasses()
 	
@@ -84,6 +265,10 @@ func (g *GameManager) selectPlayerClass() (core.DevClass, error) {
 }
 
 func (g *GameManager) getClassDisplayName(class core.DevClass) string {
+	return classDisplayName(class)
+}
+
+func classDisplayName(class core.DevClass) string {
 	classes := core.GetAvailableClasses()
 	for _, c := range classes {
 		if c.Class == class {
@@ -97,6 +282,11 @@ func (g *GameManager) IsGameOver() bool {
 	return core.IsGameOver(g.state)
 }
 
+// InPlayerTurn reports whether a player turn is already in progress (e.g. after loading a save)
+func (g *GameManager) InPlayerTurn() bool {
+	return g.state.Phase == "player" && g.state.ActionsLeft > 0
+}
+
 func (g *GameManager) DisplayStatus() {
 	player := core.GetActivePlayer(g.state)
 	if player == nil {
@@ -124,6 +314,7 @@ func (g *GameManager) DisplayPrompt() {
 	
 	fmt.Printf("\n[ACTIONS] move(mv) play(c) search(s) shoot(f) melee(ml) room(ra) pass(p)\n")
 	fmt.Printf("[INFO] hand(h) map(mp) status(st) rule(ru) list(cl) help(?) quit/exit(q)\n")
+	fmt.Printf("[GAME] save [slot] load [slot] saves\n")
 	fmt.Printf("(%d actions left) > ", g.state.ActionsLeft)
 }
 
@@ -163,19 +354,10 @@ func (g *GameManager) displayStatusPanel(player *core.PlayerState) {
 	}
 	
 	// Count enemies in room
-	loopCount := 0
-	overflowCount := 0 
-	pythogorasCount := 0
+	enemyCount := map[core.EnemyType]int{}
 	for _, enemy := range g.state.Enemies {
 		if enemy.Location == player.Location {
-			switch enemy.Type {
-			case core.InfiniteLoop:
-				loopCount++
-			case core.StackOverflow:
-				overflowCount++
-			case core.Pythogoras:
-				pythogorasCount++
-			}
+			enemyCount[enemy.Type]++
 		}
 	}
 	
@@ -202,14 +384,31 @@ func (g *GameManager) displayStatusPanel(player *core.PlayerState) {
 		fmt.Sprintf("Turn   Actions %d / 2      Cards  Hand:%d  Deck:%d  Discard:%d", 
 			g.state.ActionsLeft, len(player.Hand), len(player.Deck), len(player.Discard)),
 	)
+	if player.QuestionSkips > 0 {
+		lines = append(lines,
+			fmt.Sprintf("Skips  Question skips: %d", player.QuestionSkips),
+		)
+	}
 	lines = append(lines,
-		fmt.Sprintf("Room   Bugs:%d   Loop:%d   Overflow:%d   Pythogoras:%d   Corrupted: %s",
-			room.BugMarkers, loopCount, overflowCount, pythogorasCount, corruptedStatus),
+		fmt.Sprintf("Room   Bugs:%d   %s   Corrupted: %s",
+			room.BugMarkers, enemyCounts(enemyCount), corruptedStatus),
 	)
 	lines = append(lines,
 		fmt.Sprintf("Game   Round: %d      Rounds left: %d", 
 			g.state.Round, roundsLeft),
 	)
+	if objective, exists := core.GetObjective(player.PersonalObj); exists {
+		result := core.EvaluateObjective(g.state, player, objective, false)
+		lines = append(lines,
+			fmt.Sprintf("Goal   Personal: %s - %s (%s)", objective.Name, objective.Description, result.Progress),
+		)
+	}
+	if objective, exists := core.GetObjective(player.CorporateObj); exists {
+		result := core.EvaluateObjective(g.state, player, objective, false)
+		lines = append(lines,
+			fmt.Sprintf("Goal   Corporate: %s - %s (%s)", objective.Name, objective.Description, result.Progress),
+		)
+	}
 	
 	// ➊ work out how wide the panel really needs to be
 	width := minWidth
@@ -221,8 +420,8 @@ func (g *GameManager) displayStatusPanel(player *core.PlayerState) {
 	
 	// ➌ print top border
 	className := g.getClassDisplayName(player.Class)
-	header := fmt.Sprintf(" P1 %s ── Room %s (%s, %s) ",
-		className, player.Location, roomType, searchStatus)
+	header := fmt.Sprintf(" %s %s ── Room %s (%s, %s) ",
+		player.ID, className, player.Location, roomType, searchStatus)
 	pad := width - cells(header) - 2          // 2 for corner chars
 	fmt.Printf("\n┌%s%s┐\n", header, strings.Repeat("─", pad))
 	
@@ -236,38 +435,21 @@ func (g *GameManager) displayStatusPanel(player *core.PlayerState) {
 }
 
 func (g *GameManager) getRoomDisplayName(roomID core.RoomID) string {
-	// Simple mapping for room names
-	roomNames := map[string]string{
-		"R01": "Key", "R02": "Store", "R03": "Comp",
-		"R04": "Crew", "R05": "Lab", "R06": "Sys", 
-		"R07": "Air", "R08": "Power", "R09": "Maint",
-		"R10": "Cache", "R11": "Cache", "R12": "Start",
-		"R13": "Data", "R14": "Log", "R15": "Engine",
-		"R16": "Gen", "R17": "Engine", "R18": "Engine", 
-		"R19": "Escape", "R20": "Escape",
-	}
-	
-	if name, exists := roomNames[string(roomID)]; exists {
-		return name
-	}
-	return string(roomID)
+	// Room names come from the map file
+	return core.RoomName(roomID)
 }
 
 func (g *GameManager) getRoomTypeDisplayName(room *core.RoomState) string {
 	// Handle predefined rooms first
 	if room.Type == core.Predefined {
-		switch room.ID {
-		case "R01":
+		switch core.RoleOf(room.ID) {
+		case core.KeyRole:
 			return "key room"
-		case "R12":
+		case core.StartRole:
 			return "start room"
-		case "R15":
+		case core.EngineRole:
 			return "engine room"
-		case "R17":
-			return "engine room"
-		case "R18":
-			return "engine room"
-		case "R19", "R20":
+		case core.EscapeRole:
 			return "escape room"
 		default:
 			return "special room"
@@ -298,15 +480,20 @@ func (g *GameManager) DisplayGameOver() {
 	} else {
 		fmt.Println("💀 DEFEAT! All developers were lost to the corruption...")
 	}
+	g.displaySeed()
+	g.finishLearning()
+	g.finishDaily(false)
 }
 
 func (g *GameManager) ExecuteCommand(command string, args []string, reader *bufio.Reader) error {
 	switch command {
 	// Turn-economy actions
 	case "move", "mv":
-		return g.executeMove(args)
+		return g.executeMove(args, reader)
 	case "play", "c":
-		return g.executePlayCard(args)
+		return g.executePlayCard(args, reader)
+	case "open", "o":
+		return g.executeOpenDoor(args)
 	case "search", "s":
 		return g.executeSearch()
 	case "shoot", "f":
@@ -326,12 +513,20 @@ func (g *GameManager) ExecuteCommand(command string, args []string, reader *bufi
 	case "status", "st":
 		g.DisplayStatus()
 		return nil
+	case "actions", "a":
+		return g.showActions()
 	case "help", "?":
 		return g.showHelp()
 	case "rule", "ru":
 		return g.showRules()
 	case "list", "cl":
 		return g.showCardList()
+	case "save":
+		return g.executeSave(args)
+	case "load":
+		return g.executeLoad(args)
+	case "saves":
+		return g.showSaves()
 	case "quit", "q":
 		fmt.Println("Thanks for playing!")
 		return fmt.Errorf("quit")
@@ -346,6 +541,7 @@ func (g *GameManager) ExecuteCommand(command string, args []string, reader *bufi
 func (g *GameManager) ExecuteDrawPhase() {
 	fmt.Printf("\n=== ROUND %d: DRAW PHASE ===\n", g.state.Round)
 	core.DrawPhase(g.state)
+	g.record(func(j *core.Journal) error { return j.RecordPhase(core.StepDraw, g.state) })
 	
 	player := core.GetActivePlayer(g.state)
 	if player != nil {
@@ -354,7 +550,33 @@ func (g *GameManager) ExecuteDrawPhase() {
 }
 
 func (g *GameManager) ExecutePlayerPhase(reader *bufio.Reader) error {
-	fmt.Printf("\n=== PLAYER PHASE ===\n")
+	// Each living developer takes a full turn, in seat order
+	for {
+		if err := g.executePlayerTurn(reader); err != nil {
+			return err
+		}
+		more := core.AdvanceTurn(g.state)
+		g.record(func(j *core.Journal) error { return j.RecordPhase(core.StepAdvanceTurn, g.state) })
+		if !more {
+			break
+		}
+	}
+	
+	fmt.Println("Player phase complete.")
+	return nil
+}
+
+func (g *GameManager) executePlayerTurn(reader *bufio.Reader) error {
+	player := core.GetActivePlayer(g.state)
+	if player == nil {
+		return nil
+	}
+	
+	if len(g.state.Players) > 1 {
+		fmt.Printf("\n=== PLAYER PHASE: %s (%s) ===\n", player.ID, g.getClassDisplayName(player.Class))
+	} else {
+		fmt.Printf("\n=== PLAYER PHASE ===\n")
+	}
 	fmt.Printf("Actions remaining: %d\n", g.state.ActionsLeft)
 	
 	// Display the game map first for better situational awareness
@@ -366,6 +588,13 @@ func (g *GameManager) ExecutePlayerPhase(reader *bufio.Reader) error {
 	g.DisplayHand()
 	g.DisplayPrompt()
 	
+	// A save made mid-move resumes at its question
+	if g.state.PendingQuestion != nil {
+		if err := g.answerPendingQuestion(reader); err != nil {
+			return err
+		}
+	}
+	
 	// Player action loop - continue until actions exhausted or pass
 	for g.state.ActionsLeft > 0 {
 		
@@ -391,10 +620,15 @@ func (g *GameManager) ExecutePlayerPhase(reader *bufio.Reader) error {
 		
 		// Execute command
 		if err := g.ExecuteCommand(command, commandArgs, reader); err != nil {
-			if err.Error() == "quit" {
+			if err.Error() == "quit" || err.Error() == "load" {
 				return err
 			}
-			fmt.Printf("Error: %v\n", err)
+			var actionErr *core.ActionError
+			if errors.As(err, &actionErr) {
+				fmt.Printf("✗ %v\n", actionErr) // Rejected actions cost nothing
+			} else {
+				fmt.Printf("Error: %v\n", err)
+			}
 		}
 		
 		// Show appropriate display after command execution
@@ -408,7 +642,9 @@ func (g *GameManager) ExecutePlayerPhase(reader *bufio.Reader) error {
 		}
 	}
 	
-	fmt.Println("Player phase complete.")
+	if len(g.state.Players) > 1 {
+		fmt.Printf("%s's turn complete.\n", player.ID)
+	}
 	return nil
 }
 
@@ -418,11 +654,12 @@ func (g *GameManager) ExecuteEventPhase() {
 	
 	// Execute event phase with logging
 	core.EventPhase(g.state, log)
+	g.record(func(j *core.Journal) error { return j.RecordPhase(core.StepEvent, g.state) })
 	
 	// Stream the event log with delays for readability (1000ms per line)
 	if !log.IsEmpty() {
 		fmt.Println() // Add spacing before event phase
-		log.StreamLines(1000 * time.Millisecond) // 1 second delay for clear line-by-line visibility
+		log.StreamLines(g.effectDelay)
 	}
 	
 	fmt.Printf("Time remaining: %d rounds\n", g.state.Time)
@@ -431,18 +668,71 @@ func (g *GameManager) ExecuteEventPhase() {
 func (g *GameManager) ExecuteRoundMaintenance() {
 	fmt.Printf("\n=== ROUND MAINTENANCE ===\n")
 	core.EndRoundMaintenance(g.state)
+	g.record(func(j *core.Journal) error { return j.RecordPhase(core.StepMaintenance, g.state) })
 	fmt.Printf("Round %d complete. Starting round %d...\n", g.state.Round-1, g.state.Round)
+	g.autosave()
 }
 
 func (g *GameManager) CheckEndConditions() (ended bool, win bool) {
-	return core.CheckEndSolo(g.state)
+	return core.CheckEnd(g.state)
 }
 
 func (g *GameManager) DisplayGameResult(win bool) {
 	fmt.Println("\n========== GAME COMPLETE ==========")
-	if win {
+	team := len(g.state.Players) > 1
+	switch {
+	case win && team:
+		fmt.Println("🎉 VICTORY! Your team escaped Tutorial Hell!")
+	case win:
 		fmt.Println("🎉 VICTORY! You escaped Tutorial Hell!")
-	} else {
+	case team:
+		fmt.Println("💀 DEFEAT! Your team was consumed by the corruption...")
+	default:
 		fmt.Println("💀 DEFEAT! You were consumed by the corruption...")
 	}
+	
+	g.displayObjectiveResults(win)
+	g.displaySeed()
+	g.finishLearning()
+	g.finishDaily(win)
+}
+
+// displayObjectiveResults shows each developer's personal and corporate objective outcome
+func (g *GameManager) displayObjectiveResults(win bool) {
+	outcomes := core.EvaluateOutcomes(g.state, win)
+	if len(outcomes) == 0 {
+		return
+	}
+	
+	fmt.Println("\n---------- OBJECTIVES ----------")
+	for _, outcome := range outcomes {
+		player := g.state.Players[outcome.PlayerID]
+		fmt.Printf("%s (%s):\n", outcome.PlayerID, g.getClassDisplayName(player.Class))
+		if outcome.Personal != nil {
+			fmt.Printf("  Personal:  %s %s - %s (%s)\n", objectiveMark(outcome.Personal.Complete),
+				outcome.Personal.Objective.Name, outcome.Personal.Objective.Description, outcome.Personal.Progress)
+		}
+		if outcome.Corporate != nil {
+			fmt.Printf("  Corporate: %s %s - %s (%s)\n", objectiveMark(outcome.Corporate.Complete),
+				outcome.Corporate.Objective.Name, outcome.Corporate.Objective.Description, outcome.Corporate.Progress)
+		}
+		
+		switch {
+		case outcome.Victory && outcome.Corporate != nil && outcome.Corporate.Complete:
+			fmt.Println("  🏆 Full victory - escaped with every objective complete!")
+		case outcome.Victory:
+			fmt.Println("  🎉 Victory - escaped with personal objective complete")
+		case outcome.Escaped:
+			fmt.Println("  ⚠️  Escaped, but personal objective failed - not a true victory")
+		default:
+			fmt.Println("  💀 Did not escape")
+		}
+	}
+}
+
+func objectiveMark(complete bool) string {
+	if complete {
+		return "✓"
+	}
+	return "✘"
 }
\ No newline at end of file
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"sort"
+	"strconv"
 	"strings"
 	"io/ioutil"
 
@@ -63,9 +65,8 @@ func (g *GameManager) renderMapWithLegend() string {
 	
 	// Add statistics bar
 	result.WriteString("╔════════════════════════════════════════════════════════════════════════╗\n")
-	result.WriteString(fmt.Sprintf("║ 🐛 Total Bugs: %-3d  💀 Corrupted: %-2d  👹 Enemies: IL:%d SO:%d PY:%d        ║\n",
-		totalBugs, corruptedRooms, 
-		enemyCount[core.InfiniteLoop], enemyCount[core.StackOverflow], enemyCount[core.Pythogoras]))
+	result.WriteString(fmt.Sprintf("║ 🐛 Total Bugs: %-3d  💀 Corrupted: %-2d  👹 Enemies: %-22s║\n",
+		totalBugs, corruptedRooms, enemyCounts(enemyCount)))
 	result.WriteString("╚════════════════════════════════════════════════════════════════════════╝\n\n")
 	
 	// Add legend
@@ -73,7 +74,7 @@ func (g *GameManager) renderMapWithLegend() string {
 	result.WriteString("• Rooms: [ID,±,B*] = [Room ID, Searched(+/-), Bug count, OutOfRam(*)]\n")
 	result.WriteString("• Types: KEY=Key STR=Start EN#=Engine ESC=Escape\n")
 	result.WriteString("         AMO=Ammo MED=Medical CLN=Clean AIR=Air SPN=Spawn\n")
-	result.WriteString("• Units: P#=Player IL=Infinite Loop SO=Stack Overflow PY=Pythogoras\n")
+	result.WriteString("• Units: P#=Player " + enemyLegend(" ") + "\n")
 	result.WriteString("• Status: XXX=Unexplored room, * = OutOfRam\n")
 	
 	return result.String()
@@ -85,7 +86,7 @@ func (g *GameManager) renderMap() string {
 	
 	// No header row - remove column labels to prevent grid coordinates
 	
-	// Render each row
+	// Render each row of the current map's grid
 	for row := 0; row < core.GridRows; row++ {
 		line1, line2, line3 := g.renderGridRow(row, overflowRooms)
 		result.WriteString(line1 + "\n")
@@ -109,10 +110,67 @@ func (g *GameManager) renderMap() string {
 	// Add mini-guide with examples
 	result.WriteString("\n")
 	result.WriteString("Examples: [R12,+,0] = Room R12, not searched, 0 bugs | [R07,-,2*] = Room R07, searched, 2 bugs, OutOfRam\n")
-	result.WriteString("Content:  P1 = you, P2-P4 = other players | IL = Infinite Loop, SO = Stack Overflow, PY = Pythogoras\n")
+	result.WriteString("Content:  P1 = you, P2-P4 = other players | " + strings.ReplaceAll(enemyLegend(", "), "=", " = ") + "\n")
 	result.WriteString("\n")
-	result.WriteString("[PREDEFINED] KEY:R01 STR:R12 EN1:R15 EN2:R18 EN3:R17 ESC:R19,R20\n")
-	result.WriteString("[ROOM TYPES] AMO×3 MED×3 CLN×2 AIR×3 SPN×4\n")
+	result.WriteString(g.renderMapRoles() + "\n")
+	result.WriteString(g.renderMapTypePool() + "\n")
+	result.WriteString(g.renderMapDoors())
+	
+	return result.String()
+}
+
+// renderMapRoles lists the map's fixed rooms, e.g. "KEY:R01 STR:R12 EN1:R15 ... ESC:R19,R20"
+func (g *GameManager) renderMapRoles() string {
+	shipMap := core.CurrentMap
+	parts := []string{"KEY:" + string(shipMap.Key), "STR:" + string(shipMap.Start)}
+	for i, engine := range shipMap.Engines {
+		parts = append(parts, fmt.Sprintf("EN%d:%s", i+1, engine))
+	}
+	escapes := make([]string, len(shipMap.Escapes))
+	for i, escape := range shipMap.Escapes {
+		escapes[i] = string(escape)
+	}
+	parts = append(parts, "ESC:"+strings.Join(escapes, ","))
+	return "[PREDEFINED] " + strings.Join(parts, " ")
+}
+
+// renderMapTypePool counts the room types hidden in the unexplored rooms, e.g. "AMO×3 MED×3"
+func (g *GameManager) renderMapTypePool() string {
+	counts := make(map[core.RoomType]int)
+	for _, roomType := range core.CurrentMap.TypePool {
+		counts[roomType]++
+	}
+	var parts []string
+	for _, roomType := range []core.RoomType{core.AmmoCache, core.MedBay, core.CleanRoomType, core.Empty, core.EnemySpawn} {
+		if counts[roomType] > 0 {
+			parts = append(parts, fmt.Sprintf("%s×%d", roomTypeAbbrev(roomType), counts[roomType]))
+		}
+	}
+	return "[ROOM TYPES] " + strings.Join(parts, " ")
+}
+
+// renderMapDoors lists the walled-off passages and the doors that are not
+// open, e.g. "[DOORS] R11–R12 closed, R07–R12 locked"; empty when there are none
+func (g *GameManager) renderMapDoors() string {
+	var result strings.Builder
+	
+	var walls []string
+	for edge := range core.CurrentMap.Walls {
+		walls = append(walls, edge.String())
+	}
+	if len(walls) > 0 {
+		sort.Strings(walls)
+		result.WriteString("[WALLS] " + strings.Join(walls, " ") + "\n")
+	}
+	
+	var doors []string
+	for edge, door := range g.state.Doors {
+		doors = append(doors, fmt.Sprintf("%s %s", edge, door))
+	}
+	if len(doors) > 0 {
+		sort.Strings(doors)
+		result.WriteString("[DOORS] " + strings.Join(doors, ", ") + "\n")
+	}
 	
 	return result.String()
 }
@@ -137,7 +195,7 @@ func (g *GameManager) renderGridRow(row int, overflowRooms map[core.RoomID][]str
 	line2.WriteString("")
 	line3.WriteString("")
 	
-	for col := 0; col < 6; col++ { // Only show columns A-F (0-5)
+	for col := 0; col < core.GridCols; col++ {
 		roomID := g.findRoomAt(row, col)
 		
 		if roomID == "" {
@@ -185,18 +243,14 @@ func (g *GameManager) findRoomAt(row, col int) string {
 
 func (g *GameManager) getRoomTypeDisplay(room *core.RoomState) string {
 	// Predefined rooms are always known
-	switch room.ID {
-	case "R01":
+	switch core.RoleOf(room.ID) {
+	case core.KeyRole:
 		return "KEY"
-	case "R12":
+	case core.StartRole:
 		return "STR"
-	case "R15":
-		return "EN1"
-	case "R17":
-		return "EN3"
-	case "R18":
-		return "EN2"
-	case "R19", "R20":
+	case core.EngineRole:
+		return fmt.Sprintf("EN%d", core.EngineNumber(room.ID))
+	case core.EscapeRole:
 		return "ESC"
 	}
 	
@@ -206,7 +260,12 @@ func (g *GameManager) getRoomTypeDisplay(room *core.RoomState) string {
 	}
 	
 	// Otherwise show actual room type
-	switch room.Type {
+	return roomTypeAbbrev(room.Type)
+}
+
+// roomTypeAbbrev returns the three-letter map code of a room type
+func roomTypeAbbrev(roomType core.RoomType) string {
+	switch roomType {
 	case core.AmmoCache:
 		return "AMO"
 	case core.MedBay:
@@ -262,16 +321,57 @@ func (g *GameManager) formatGridContents(room *core.RoomState, overflowRooms map
 }
 
 func (g *GameManager) getEnemyAbbrev(enemyType core.EnemyType) string {
-	switch enemyType {
-	case core.InfiniteLoop:
-		return "IL"
-	case core.StackOverflow:
-		return "SO"
-	case core.Pythogoras:
-		return "PY"
-	default:
-		return "??"
+	if def, exists := core.EnemyDB[enemyType]; exists {
+		return def.Abbrev
+	}
+	return "??"
+}
+
+// enemyLegend lists every enemy type as "IL=Infinite Loop" joined by sep
+func enemyLegend(sep string) string {
+	var entries []string
+	for _, enemyType := range core.EnemyTypes() {
+		def := core.EnemyDB[enemyType]
+		entries = append(entries, def.Abbrev+"="+def.Name)
+	}
+	return strings.Join(entries, sep)
+}
+
+// enemyRules lists every enemy type with its stats, alertness and abilities
+// for the rules screen, followed by the noise model
+func enemyRules() string {
+	var lines []string
+	for _, enemyType := range core.EnemyTypes() {
+		def := core.EnemyDB[enemyType]
+		lines = append(lines, fmt.Sprintf("• %s (%d HP, %d DMG): %s", def.Name, def.HP, def.Damage, def.Description))
+		switch {
+		case def.Speed == 0:
+			lines = append(lines, "    ↳ never leaves its room")
+		case def.Alertness == 0:
+			lines = append(lines, "    ↳ always on the hunt")
+		default:
+			lines = append(lines, fmt.Sprintf("    ↳ hunts after hearing %d noise within %d room(s)", def.Alertness, def.Hearing))
+		}
+		for _, ability := range def.Abilities {
+			lines = append(lines, "    ↳ "+ability.Describe())
+		}
+	}
+	noise := core.ActionNoise
+	lines = append(lines, "",
+		"Each event phase, every enemy attacks developers in its room, hunts if it heard",
+		"enough noise since the last one, or lurks. Noise made in your room:",
+		fmt.Sprintf("move %d, search %d, melee %d, shoot %d, room ability %d.", noise.Move, noise.Search, noise.Melee, noise.Shoot, noise.Room))
+	return strings.Join(lines, "\n")
+}
+
+// enemyCounts renders enemy counts by type for every enemy type, e.g.
+// "IL:2 SO:1 PY:0"
+func enemyCounts(counts map[core.EnemyType]int) string {
+	var entries []string
+	for _, enemyType := range core.EnemyTypes() {
+		entries = append(entries, fmt.Sprintf("%s:%d", core.EnemyDB[enemyType].Abbrev, counts[enemyType]))
 	}
+	return strings.Join(entries, " ")
 }
 
 // formatRoomStatus formats room status for separate display
@@ -291,12 +391,91 @@ func (g *GameManager) formatRoomStatus(room *core.RoomState) string {
 	}
 }
 
+// showActions lists the active player's options from core.LegalActions,
+// greyed out with the reason when an option is not available.
+func (g *GameManager) showActions() error {
+	options := core.LegalActions(g.state)
+	if len(options) == 0 {
+		fmt.Println("No actions available.")
+		return nil
+	}
+	
+	fmt.Println("\nAvailable actions:")
+	for _, option := range options {
+		if option.Legal() {
+			fmt.Printf("  ✓ %s\n", g.describeAction(option))
+		} else {
+			fmt.Printf("  ✗ %-28s %s\n", g.describeAction(option), option.Reason())
+		}
+	}
+	return nil
+}
+
+// describeAction renders an option as the command that would take it
+func (g *GameManager) describeAction(option core.ActionOption) string {
+	switch a := option.Action.(type) {
+	case core.MoveAction:
+		var notes []string
+		if option.NeedsQuestion {
+			notes = append(notes, "question")
+		}
+		if option.Cost > 1 {
+			notes = append(notes, fmt.Sprintf("%d actions", option.Cost))
+		}
+		if len(notes) > 0 {
+			return fmt.Sprintf("move %s (%s)", a.To, strings.Join(notes, ", "))
+		}
+		return fmt.Sprintf("move %s", a.To)
+	case core.AnswerQuestionAction:
+		if option.NeedsText {
+			return "answer <text>"
+		}
+		if len(a.Choices) > 0 {
+			numbers := make([]string, len(a.Choices))
+			for i, choice := range a.Choices {
+				numbers[i] = strconv.Itoa(choice + 1)
+			}
+			return "answer " + strings.Join(numbers, ",")
+		}
+		return fmt.Sprintf("answer %d", a.Choice+1)
+	case core.SkipQuestionAction:
+		return "skip (spend a question skip)"
+	case core.PlayCardAction:
+		command := fmt.Sprintf("play %s", a.CardID)
+		if a.Target != "" {
+			command += " " + string(a.Target)
+		}
+		if card, exists := core.CardDB[a.CardID]; exists {
+			if option.NeedsQuestion {
+				return fmt.Sprintf("%s (%s, question)", command, card.Name)
+			}
+			return fmt.Sprintf("%s (%s)", command, card.Name)
+		}
+		return command
+	case core.OpenDoorAction:
+		return fmt.Sprintf("open %s", a.To)
+	case core.SearchAction:
+		return "search"
+	case core.ShootAction:
+		return "shoot"
+	case core.MeleeAction:
+		return "melee"
+	case core.RoomAction:
+		return "room"
+	case core.PassAction:
+		return "pass"
+	default:
+		return fmt.Sprintf("%T", a)
+	}
+}
+
 func (g *GameManager) showHelp() error {
 	fmt.Println("\n=== Devesis: Tutorial Hell - Commands ===")
 	fmt.Println()
 	fmt.Println("Turn-economy actions (cost a turn):")
 	fmt.Println("  move <roomID>  (mv)  - Move to adjacent room")
-	fmt.Println("  play <cardID>  (c)   - Play a card from hand")
+	fmt.Println("  open <roomID>  (o)   - Open the shut door to an adjacent room")
+	fmt.Println("  play <cardID>  (c)   - Play a card from hand (play <cardID> <roomID> for movement cards)")
 	fmt.Println("  search         (s)   - Search current room")
 	fmt.Println("  shoot          (f)   - Attack enemies in adjacent rooms")
 	fmt.Println("  melee          (ml)  - Attack enemies in current room")
@@ -307,11 +486,18 @@ func (g *GameManager) showHelp() error {
 	fmt.Println("  hand           (h)   - Show your cards")
 	fmt.Println("  map            (mp)  - Display game map")
 	fmt.Println("  status         (st)  - Show current status")
+	fmt.Println("  actions        (a)   - List what you can do right now")
 	fmt.Println("  help           (?)   - Show this help")
 	fmt.Println("  rule           (ru)  - Show game rules (pager view)")
 	fmt.Println("  list           (cl)  - Show all cards (pager view)")
 	fmt.Println("  quit           (q)   - Exit game")
 	fmt.Println()
+	fmt.Println("Saved games (free):")
+	fmt.Println("  save [slot]          - Save the game (default slot: quicksave)")
+	fmt.Println("  load [slot]          - Load a saved game (default slot: quicksave)")
+	fmt.Println("  saves                - List saved games")
+	fmt.Println("  (the game autosaves every round; start with --resume to continue)")
+	fmt.Println()
 	
 	return nil
 }
@@ -328,22 +514,30 @@ and activate the escape pods while surviving the programming malware that infest
 
 WIN CONDITION
 -------------
-1. Search engine rooms (R15, R17, R18) to collect 3 Engine Core cards
-2. Navigate to escape rooms (R19 or R20)  
+1. Search an engine room (EN# on the map) to collect 3 Engine Core cards
+2. Navigate to an escape room (ESC)  
 3. Play an Engine Core card at the escape room (if no Pythogoras present)
 4. Victory! You've escaped Tutorial Hell!
 
+OBJECTIVES
+----------
+Each developer is dealt a personal and a corporate objective (see status panel).
+Escaping only counts as a true victory if your personal objective is complete.
+Corporate objectives are bonus goals scored on the final screen.
+
 TURN STRUCTURE (4 Phases)
 -------------------------
 1. DRAW PHASE: Draw 5 cards on turn 1, then 2 cards per turn
 2. PLAYER PHASE: Take up to 2 actions per turn
-3. EVENT PHASE: Time decreases, enemies attack/move, corruption spreads
+   (hot-seat games: each living developer takes their own turn, P1 → P4)
+3. EVENT PHASE: Time decreases, enemies attack, hunt noise or lurk, corruption spreads
 4. ROUND MAINTENANCE: Advance to next round
 
 PLAYER ACTIONS (Cost 1 Action Each)
 ----------------------------------
 • move <roomID>    - Move to adjacent room (triggers coding question)
 • play <cardID>    - Play a card from your hand  
+• play <cardID> <roomID> - Sprint to a room with a movement card (e.g. Sprint Planning)
 • search           - Search current room for special items
 • shoot            - Attack enemies in adjacent rooms (costs 1 ammo)
 • melee            - Attack enemies in current room (no ammo cost)
@@ -360,16 +554,15 @@ INFORMATION COMMANDS (Free)
 
 SPECIAL ROOMS
 -------------
-• R01 (KEY): Search to gain BOOT.dev KEY (increases damage from 1 to 3)
-• R15/R17/R18 (Engines): Search to gain 3 Engine Core cards
-• R19/R20 (Escape): Play Engine Core here to win (if no Pythogoras)
-• R12 (Start): Your starting location
+Each map marks its fixed rooms (see [PREDEFINED] under the map):
+• KEY: Search to gain BOOT.dev KEY (increases damage from 1 to 3)
+• EN1, EN2, ... (Engines): Search to gain 3 Engine Core cards
+• ESC (Escape): Play Engine Core here to win (if no Pythogoras)
+• STR (Start): Your starting location
 
 ENEMIES
 -------
-• Infinite Loop (1 HP, 1 DMG): Weak but numerous
-• Stack Overflow (3 HP, 1 DMG): Medium threat  
-• Pythogoras (6 HP, 1 DMG): Powerful boss - blocks escape rooms
+` + enemyRules() + `
 
 RESOURCES
 ---------
@@ -381,11 +574,25 @@ RESOURCES
 MOVEMENT & QUESTIONS
 ------------------
 • You can move to any orthogonally adjacent room (4 directions)
-• All rooms are passable, including corrupted ones
+• All rooms are passable, but entering a corrupted room costs a toll (see below)
+• Walls (listed under the map) block a passage for good
+• Closed and locked doors block everyone (see [DOORS] under the map):
+  - Closed: open it for an action; an enemy stops there and breaks it down
+  - Locked: unlocking takes an action and leaves it closed; an enemy stops
+    to smash the lock
+  - Destroyed: open for good and can't be closed again
+  Cards (Close Your Tabs, Firewall Rules) and events close, lock or break doors
 • Moving between rooms has consequences (equal 1/3 chance each):
   - 1 bug placed in room you left
   - 1 bug placed in up to 2 random adjacent rooms to where you left  
   - Safe movement (no bugs)
+• Entering an unexplored room asks a coding question first:
+  - KEY and engine rooms start unexplored and ask harder questions; at 2 HP or less they get easier
+  - Correct: a special card (a rare one for hard questions)
+  - Wrong: you lose your hand and bugs spread to the room and its neighbours
+    (only the room itself for easy questions)
+  - Question skips from cards (Rubber Duck, Pair Programming, Senior Dev
+    On Call) let you type "skip" instead: you move in with no reward or penalty
 
 At 3+ bugs, rooms become corrupted and spawn Infinite Loop enemies every event phase.
 
@@ -393,7 +600,12 @@ CORRUPTION SYSTEM
 -----------------
 • Rooms automatically become corrupted when they reach 3+ bug markers
 • During each event phase, every corrupted room spawns 1 Infinite Loop enemy
-• Corrupted rooms are still passable and searchable
+• Entering a corrupted room costs an extra action, even if it is explored
+• With only one action left, you must answer a coding question at the door
+  instead - a wrong answer keeps you out (no bugs spread, no cards lost)
+• Corrupted rooms are still searchable once you are inside
+• Stack Overflows path around corrupted rooms when they can; Infinite Loops
+  and Pythogoras go straight through
 • Room abilities (MedBay heal, AmmoCache reload, CleanRoom debug) work normally in corrupted rooms
 • Corruption spreads the danger - clear bugs quickly to prevent enemy multiplication!
 
@@ -406,7 +618,7 @@ CARD SYSTEM
 
 TIPS FOR SURVIVAL
 -----------------
-1. Search the KEY room (R01) early for damage boost
+1. Search the KEY room early for damage boost
 2. Collect all 3 engines before heading to escape
 3. Clear Pythogoras from escape rooms before playing Engine Core
 4. Manage ammo and HP carefully - use room abilities when possible
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spaceship/devesis/pkg/core"
+)
+
+// journalsDir returns the directory holding action journals under the user's config directory
+func journalsDir() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	return filepath.Join(configDir, "devesis", "journals"), nil
+}
+
+// startJournal opens the journal file and records the starting state.
+// An empty path writes a timestamped file into the journals directory.
+func (g *GameManager) startJournal(path string) error {
+	if path == "" {
+		dir, err := journalsDir()
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create journals directory: %w", err)
+		}
+		path = filepath.Join(dir, time.Now().Format("20060102-150405")+".jsonl")
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create journal: %w", err)
+	}
+	g.journalFile = file
+	g.journal = core.NewJournal(file)
+
+	g.record(func(j *core.Journal) error { return j.RecordStart(g.state) })
+	if g.journal != nil {
+		fmt.Printf("📼 Recording journal to %s\n", path)
+	}
+	return nil
+}
+
+// record writes a journal entry; a failed write stops journaling rather than the game
+func (g *GameManager) record(write func(j *core.Journal) error) {
+	if g.journal == nil {
+		return
+	}
+	if err := write(g.journal); err != nil {
+		fmt.Printf("⚠️ Journal disabled: %v\n", err)
+		g.closeJournal()
+	}
+}
+
+// closeJournal flushes and closes the journal file
+func (g *GameManager) closeJournal() {
+	if g.journalFile != nil {
+		g.journalFile.Close()
+	}
+	g.journal = nil
+	g.journalFile = nil
+}
+
+// runReplay implements `devesis replay <file>`: re-runs a journal, streams its
+// effects and fails on the first checkpoint that does not match.
+func runReplay(args []string) int {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	delay := flags.Duration("delay", 0, "pause between effect lines (e.g. 200ms)")
+	dataDir := flags.String("data-dir", "./data", "directory containing cards.yaml, objectives.yaml, questions.yaml and maps/")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: devesis replay [-delay 200ms] <journal.jsonl>")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	if err := core.LoadCards(*dataDir); err != nil {
+		fmt.Printf("Failed to load cards: %v\n", err)
+		return 1
+	}
+	if err := core.LoadEnemies(*dataDir); err != nil {
+		fmt.Printf("Failed to load enemies: %v\n", err)
+		return 1
+	}
+	if err := core.LoadObjectives(*dataDir); err != nil {
+		fmt.Printf("Failed to load objectives: %v\n", err)
+		return 1
+	}
+	if err := core.LoadQuestions(*dataDir); err != nil {
+		fmt.Printf("Failed to load questions: %v\n", err)
+		return 1
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		fmt.Printf("Failed to open journal: %v\n", err)
+		return 1
+	}
+	defer file.Close()
+
+	replayer := core.NewReplayer()
+	entries := 0
+	err = core.ReadJournalEntries(file, func(entry core.JournalEntry) error {
+		log := core.NewEffectLog()
+		if err := replayer.Step(entry, log); err != nil {
+			return err
+		}
+		entries++
+		if entry.Kind == core.EntryStart {
+			// Every start snapshot names the map the following actions play on
+			if err := loadGameMap(*dataDir, replayer.State()); err != nil {
+				return err
+			}
+		}
+
+		fmt.Println(describeJournalEntry(entry, replayer.State()))
+		if entry.Kind == core.EntryStart && replayer.StartInfo().CardDBChanged {
+			fmt.Println("🃏 Card database changed since this journal was recorded - divergence is likely.")
+		}
+		log.StreamLines(*delay)
+		return nil
+	})
+
+	var divergence *core.ReplayDivergenceError
+	switch {
+	case errors.As(err, &divergence):
+		fmt.Printf("\n✗ REPLAY DIVERGED at entry %d (%s)\n", divergence.Seq, divergence.Kind)
+		fmt.Printf("  journal checkpoint: %s\n  recomputed state:   %s\n", divergence.Expected, divergence.Got)
+		return 1
+	case err != nil:
+		fmt.Printf("\n✗ Replay failed: %v\n", err)
+		return 1
+	case replayer.State() == nil:
+		fmt.Println("✗ Journal is empty.")
+		return 1
+	}
+
+	state := replayer.State()
+	fmt.Printf("\n✓ Replay verified: %d entries, round %d, time %d.\n", entries, state.Round, state.Time)
+	if ended, win := core.CheckEnd(state); ended {
+		if win {
+			fmt.Println("Result: VICTORY")
+		} else {
+			fmt.Println("Result: DEFEAT")
+		}
+	}
+	return 0
+}
+
+// describeJournalEntry renders the header line printed before an entry's effects
+func describeJournalEntry(entry core.JournalEntry, state *core.GameState) string {
+	switch entry.Kind {
+	case core.EntryStart:
+		return fmt.Sprintf("\n[#%d] ▶ start: round %d, %d developer(s)", entry.Seq, state.Round, len(state.Players))
+	case core.EntryAction:
+		return fmt.Sprintf("[#%d] %s %s", entry.Seq, entry.ActionType, entry.Action)
+	case core.EntryPhase:
+		if entry.Phase == core.StepDraw {
+			return fmt.Sprintf("\n[#%d] === ROUND %d: %s ===", entry.Seq, state.Round, entry.Phase)
+		}
+		return fmt.Sprintf("[#%d] --- %s ---", entry.Seq, entry.Phase)
+	default:
+		return fmt.Sprintf("[#%d] %s", entry.Seq, entry.Kind)
+	}
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spaceship/devesis/pkg/core"
+)
+
+// unsafeProfileChars are replaced when a player name becomes a file name
+var unsafeProfileChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// profileName returns the learning profile name for the i-th developer (0 = P1).
+// Developers without a --name entry share the first name with a -p2, -p3 ... suffix.
+func profileName(names []string, index int) string {
+	if index < len(names) && names[index] != "" {
+		return names[index]
+	}
+	base := defaultPlayerName()
+	if len(names) > 0 && names[0] != "" {
+		base = names[0]
+	}
+	if index == 0 {
+		return base
+	}
+	return fmt.Sprintf("%s-p%d", base, index+1)
+}
+
+// profilePath returns the profile file for a player name under the config directory
+func profilePath(name string) (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	fileName := unsafeProfileChars.ReplaceAllString(strings.ToLower(name), "_") + ".json"
+	return filepath.Join(configDir, "devesis", "profiles", fileName), nil
+}
+
+// readProfile loads a player's learning profile; a missing file is a fresh profile
+func readProfile(name string) (*core.LearningProfile, error) {
+	path, err := profilePath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return core.NewLearningProfile(name), nil
+		}
+		return nil, fmt.Errorf("failed to read learning profile: %w", err)
+	}
+
+	profile := core.NewLearningProfile(name)
+	if err := json.Unmarshal(data, profile); err != nil {
+		return nil, fmt.Errorf("failed to decode learning profile %s: %w", path, err)
+	}
+	return profile, nil
+}
+
+// writeProfile stores a learning profile
+func writeProfile(profile *core.LearningProfile) error {
+	path, err := profilePath(profile.Name)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(profile, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode learning profile: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create profile directory: %w", err)
+	}
+
+	// Write to a temp file first so a crash mid-write never loses the history
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write learning profile: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to write learning profile: %w", err)
+	}
+	return nil
+}
+
+// sortedPlayerIDs returns the developers in turn order (P1, P2, ...)
+func (g *GameManager) sortedPlayerIDs() []core.PlayerID {
+	ids := make([]core.PlayerID, 0, len(g.state.Players))
+	for id := range g.state.Players {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// assignProfiles picks the learning profile of every developer from the --name entries
+func (g *GameManager) assignProfiles(names []string) {
+	g.playerNames = names
+	g.profileNames = make(map[core.PlayerID]string)
+	for i, id := range g.sortedPlayerIDs() {
+		g.profileNames[id] = profileName(names, i)
+	}
+}
+
+// scheduleQuestions reorders the new game's questions from the developers'
+// learning profiles so missed questions come back first
+func (g *GameManager) scheduleQuestions() {
+	if g.profileNames == nil {
+		return
+	}
+
+	var profiles []*core.LearningProfile
+	for _, id := range g.sortedPlayerIDs() {
+		profile, err := readProfile(g.profileNames[id])
+		if err != nil {
+			fmt.Printf("⚠️ Learning profile ignored: %v\n", err)
+			continue
+		}
+		profiles = append(profiles, profile)
+		if due := profile.DueCount(); due > 0 {
+			fmt.Printf("📚 %s: %d question(s) due for review this game\n", profile.Name, due)
+		}
+	}
+	g.state.QuestionOrder = core.ScheduleQuestions(g.state.QuestionOrder, profiles...)
+}
+
+// finishLearning updates each developer's learning profile with the game's
+// answers and shows what they learned and what to review
+func (g *GameManager) finishLearning() {
+	if g.profileNames == nil {
+		return
+	}
+
+	if len(g.state.AnswerHistory) > 0 {
+		fmt.Println("\n📚 LEARNING REPORT")
+		for _, id := range g.sortedPlayerIDs() {
+			g.displayLearningSummary(id, core.SummarizeLearning(g.state, id))
+		}
+		fmt.Println("\nMissed questions come back early in your next game.")
+	}
+
+	for _, id := range g.sortedPlayerIDs() {
+		profile, err := readProfile(g.profileNames[id])
+		if err == nil {
+			profile.RecordGame(g.state, id)
+			err = writeProfile(profile)
+		}
+		if err != nil {
+			fmt.Printf("⚠️ Could not update learning profile: %v\n", err)
+		}
+	}
+}
+
+// displayLearningSummary prints one developer's learned and missed questions
+func (g *GameManager) displayLearningSummary(id core.PlayerID, summary core.LearningSummary) {
+	if len(summary.Learned) == 0 && len(summary.Review) == 0 {
+		return
+	}
+
+	fmt.Printf("\n%s (%s): %d correct, %d to review\n", id, g.profileNames[id], len(summary.Learned), len(summary.Review))
+	if len(summary.Learned) > 0 {
+		fmt.Println("  ✓ What you learned:")
+		for _, question := range summary.Learned {
+			fmt.Printf("    • [%s] %s\n", question.Category, question.Text)
+		}
+	}
+	if len(summary.Review) > 0 {
+		fmt.Println("  ✗ What to review:")
+		for _, question := range summary.Review {
+			fmt.Printf("    • [%s] %s\n", question.Category, question.Text)
+			fmt.Printf("      Answer: %s\n", correctAnswerText(question))
+			if question.Explanation != "" {
+				fmt.Printf("      💡 %s\n", question.Explanation)
+			}
+		}
+	}
+}
+
+// correctAnswerText renders the correct answer of any question kind
+func correctAnswerText(question core.Question) string {
+	switch question.Kind {
+	case core.MultiQuestion:
+		answers := make([]string, len(question.CorrectAnswers))
+		for i, choice := range question.CorrectAnswers {
+			answers[i] = question.Options[choice]
+		}
+		return strings.Join(answers, ", ")
+	case core.TextQuestion:
+		return question.Accepted[0]
+	default:
+		return question.Options[question.CorrectAnswer]
+	}
+}
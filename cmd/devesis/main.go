@@ -2,26 +2,92 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
 	"os"
+	"strings"
+
+	"github.com/spaceship/devesis/pkg/core"
 )
 
 func main() {
+	// Subcommands are dispatched before flag parsing
+	if len(os.Args) > 1 && os.Args[1] == "replay" {
+		os.Exit(runReplay(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "map" {
+		os.Exit(runMap(os.Args[2:]))
+	}
+	// `devesis daily` plays today's shared-seed challenge and takes the regular flags
+	daily := len(os.Args) > 1 && os.Args[1] == "daily"
+	args := os.Args[1:]
+	if daily {
+		args = os.Args[2:]
+	}
+
+	seed := flag.Int64("seed", 0, "game seed; the same seed and setup replays the same dungeon (0 = random)")
+	classList := flag.String("class", "", "comma-separated classes for P1, P2, ... (frontend, backend, devops, fullstack)")
+	players := flag.Int("players", 0, fmt.Sprintf("number of developers, 1-%d (default: ask)", core.MaxPlayers))
+	difficulty := flag.String("difficulty", string(core.Normal), "enemy difficulty: easy, normal or hard")
+	dataDir := flag.String("data-dir", "./data", "directory containing cards.yaml, objectives.yaml, questions.yaml and maps/")
+	mapName := flag.String("map", core.DefaultMapName, "ship map to play: a file in <data-dir>/maps without .yaml, or random for a map generated from the seed")
+	noDelay := flag.Bool("no-delay", false, "show effects without pausing between lines")
+	resume := flag.Bool("resume", false, "resume the last autosave instead of starting a new game")
+	slot := flag.String("slot", autosaveSlot, "save slot to resume with --resume")
+	journalPath := flag.String("journal", "", "write the action journal to this file (default: timestamped file in the config dir)")
+	noJournal := flag.Bool("no-journal", false, "do not record an action journal")
+	name := flag.String("name", defaultPlayerName(), "comma-separated names for P1, P2, ...; picks each learning profile and the daily leaderboard name")
+	noProfile := flag.Bool("no-profile", false, "do not use or update learning profiles")
+	flag.CommandLine.Parse(args)
+
 	game := NewGameManager()
 
+	opts, err := buildInitOptions(*seed, *classList, *players, *difficulty)
+	if err != nil {
+		fmt.Printf("Invalid options: %v\n", err)
+		os.Exit(2)
+	}
+	opts.DataDir = *dataDir
+	opts.MapName = *mapName
+	opts.NoDelay = *noDelay
+	opts.NoProfile = *noProfile
+	for _, playerName := range strings.Split(*name, ",") {
+		opts.PlayerNames = append(opts.PlayerNames, strings.TrimSpace(playerName))
+	}
+	if *resume {
+		opts.ResumeSlot = *slot
+	}
+	if daily {
+		if err := validateDailyOptions(opts); err != nil {
+			fmt.Printf("Invalid options: %v\n", err)
+			os.Exit(2)
+		}
+		opts.DailyDate = dailyDate()
+		opts.PlayerName = opts.PlayerNames[0]
+	}
+
 	// Initialize new game or load saved state
-	if err := game.Initialize(); err != nil {
+	if err := game.Initialize(opts); err != nil {
 		fmt.Printf("Failed to initialize game: %v\n", err)
 		os.Exit(1)
 	}
 
+	if !*noJournal {
+		if err := game.startJournal(*journalPath); err != nil {
+			fmt.Printf("⚠️ Journal disabled: %v\n", err)
+		}
+	}
+	defer game.closeJournal()
+
 	reader := bufio.NewReader(os.Stdin)
 
 	// Main game loop with 4-phase structure
 	quitByPlayer := false
 	for !game.IsGameOver() {
-		// Phase 1: Draw Phase
-		game.ExecuteDrawPhase()
+		// Phase 1: Draw Phase (skipped when resuming a save made mid-turn)
+		if !game.InPlayerTurn() {
+			game.ExecuteDrawPhase()
+		}
 		
 		// Phase 2: Player Phase (action-driven commands)
 		if err := game.ExecutePlayerPhase(reader); err != nil {
@@ -29,6 +95,9 @@ func main() {
 				quitByPlayer = true
 				break
 			}
+			if err.Error() == "load" {
+				continue // Restart the round from the loaded state
+			}
 			fmt.Printf("Player phase error: %v\n", err)
 		}
 		
@@ -49,4 +118,35 @@ func main() {
 		// Game over due to normal end conditions
 		game.DisplayGameOver()
 	}
-}
\ No newline at end of file
+}
+
+// buildInitOptions validates the game-setup flags
+func buildInitOptions(seed int64, classList string, players int, difficulty string) (InitOptions, error) {
+	opts := InitOptions{Seed: seed, Players: players}
+
+	if players < 0 || players > core.MaxPlayers {
+		return opts, fmt.Errorf("--players must be between 1 and %d", core.MaxPlayers)
+	}
+
+	if classList != "" {
+		for _, name := range strings.Split(classList, ",") {
+			class, err := core.ParseDevClass(strings.TrimSpace(name))
+			if err != nil {
+				return opts, err
+			}
+			opts.Classes = append(opts.Classes, class)
+		}
+		if len(opts.Classes) > core.MaxPlayers {
+			return opts, fmt.Errorf("--class lists %d classes, at most %d developers can play", len(opts.Classes), core.MaxPlayers)
+		}
+		if players > 0 && len(opts.Classes) > players {
+			return opts, fmt.Errorf("--class lists %d classes but --players is %d", len(opts.Classes), players)
+		}
+	}
+
+	var err error
+	if opts.Difficulty, err = core.ParseDifficulty(difficulty); err != nil {
+		return opts, err
+	}
+	return opts, nil
+}
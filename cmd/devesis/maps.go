@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/spaceship/devesis/pkg/core"
+)
+
+// runMap runs `devesis map <command>`; generate prints a generated map
+func runMap(args []string) int {
+	if len(args) == 0 || args[0] != "generate" {
+		fmt.Println("usage: devesis map generate [-seed N] [-rows R] [-cols C] [-rooms N]")
+		return 2
+	}
+
+	defaults := core.DefaultMapGenOptions
+	flags := flag.NewFlagSet("map generate", flag.ExitOnError)
+	seed := flags.Int64("seed", 0, "generator seed (0 = random)")
+	rows := flags.Int("rows", defaults.Rows, fmt.Sprintf("grid rows, 3-%d", core.MaxGeneratedRows))
+	cols := flags.Int("cols", defaults.Cols, fmt.Sprintf("grid columns, 3-%d", core.MaxGeneratedCols))
+	rooms := flags.Int("rooms", defaults.Rooms, fmt.Sprintf("number of rooms, at least %d", core.MinGeneratedRooms))
+	flags.Parse(args[1:])
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	shipMap, err := core.GenerateMap(*seed, core.MapGenOptions{Rows: *rows, Cols: *cols, Rooms: *rooms})
+	if err != nil {
+		fmt.Printf("Failed to generate map: %v\n", err)
+		return 1
+	}
+
+	game := NewGameManager()
+	game.state = newMapPreviewState()
+	fmt.Printf("🗺️  %s\n%s\n\n", shipMap.Name, shipMap.Description)
+	fmt.Print(game.renderMapWithLegend())
+	fmt.Printf("\nPlay it with --map %s\n", shipMap.Name)
+	return 0
+}
+
+// newMapPreviewState lays out the current map's rooms as a new game would,
+// with no developers aboard and the pool, key and engine rooms still
+// unexplored
+func newMapPreviewState() *core.GameState {
+	state := &core.GameState{Rooms: make(map[core.RoomID]*core.RoomState)}
+	for id := range core.CurrentMap.Rooms {
+		room := &core.RoomState{ID: id, Type: core.Empty}
+		if core.RoleOf(id) != core.NoRole {
+			room.Type = core.Predefined
+			room.Explored = !core.IsHighValueRoom(id)
+		}
+		state.Rooms[id] = room
+	}
+	return state
+}
@@ -4,13 +4,23 @@ import (
 	"bufio"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/spaceship/devesis/pkg/core"
 )
 
-// PreviewAndConfirm shows what effects will happen and asks for confirmation
+// PreviewAndConfirm shows what effects will happen and asks for confirmation.
+// Moves and movement cards get a movement preview instead, explaining what
+// entering each room costs.
 func (g *GameManager) PreviewAndConfirm(action core.Action, reader *bufio.Reader) bool {
+	switch a := action.(type) {
+	case core.MoveAction:
+		return g.previewMove(a, reader)
+	case core.PlayCardAction:
+		if a.Target != "" {
+			return g.previewSprint(a, reader)
+		}
+	}
+	
 	// Create a copy of the state for preview
 	previewState := core.DeepCopyGameState(*g.state)
 	
@@ -40,21 +50,106 @@ func (g *GameManager) PreviewAndConfirm(action core.Action, reader *bufio.Reader
 	return true
 }
 
-// ResolveWithLogging applies the action and streams the effects
-func (g *GameManager) ResolveWithLogging(action core.Action) {
+// previewMove explains the coding question guarding an unexplored room and the
+// toll of a corrupted one before the player commits. Moves into explored,
+// clean rooms go ahead without asking.
+func (g *GameManager) previewMove(move core.MoveAction, reader *bufio.Reader) bool {
+	room := g.state.Rooms[move.To]
+	exploring := !room.Explored && core.PeekQuestion(g.state).ID != -1
+	tax := core.CorruptionTaxFor(g.state, move)
+	if !exploring && tax == core.NoTax {
+		if room.Explored {
+			fmt.Printf("Moving to explored room %s (no question needed).\n", move.To)
+		}
+		return true
+	}
+	
+	fmt.Println("\n— Movement Preview —")
+	difficulty := core.QuestionDifficultyFor(g.state, move.PlayerID, move.To)
+	if exploring {
+		fmt.Printf("⚠️  Warning: %s is unexplored! You'll need to answer a %s coding question.\n", move.To, difficulty)
+		fmt.Printf("Wrong answers cause bugs to spread and you lose all cards!\n")
+	}
+	switch tax {
+	case core.ExtraActionTax:
+		fmt.Printf("💀 %s is corrupted: this move costs %d actions (you have %d).\n", move.To, core.ActionCost(g.state, move), g.state.ActionsLeft)
+	case core.QuestionTax:
+		fmt.Printf("💀 %s is corrupted and this is your last action: answer a %s coding question to get in.\n", move.To, difficulty)
+		fmt.Printf("A wrong answer keeps you out and still uses the action.\n")
+	}
+	
+	return confirm(reader)
+}
+
+// previewSprint shows the path a movement card takes and what each room on
+// it asks of the player
+func (g *GameManager) previewSprint(play core.PlayCardAction, reader *bufio.Reader) bool {
+	card := core.CardDB[play.CardID]
+	path := core.PlanSprint(g.state, play.PlayerID, play.Target, core.SprintSteps(card)).Path
+	
+	fmt.Println("\n— Movement Preview —")
+	fmt.Printf("🏃 %s: %s (%d rooms)\n", card.Name, joinRoomIDs(path, " → "), len(path)-1)
+	questionsLeft := core.PeekQuestion(g.state).ID != -1
+	for _, id := range path[1:] {
+		switch {
+		case g.state.Rooms[id].Explored:
+			fmt.Printf("   %s: explored - you dash straight through\n", id)
+		case questionsLeft:
+			difficulty := core.QuestionDifficultyFor(g.state, play.PlayerID, id)
+			fmt.Printf("   %s: unexplored - %s coding question; a wrong answer ends the sprint there\n", id, difficulty)
+		default:
+			fmt.Printf("   %s: unexplored - no questions left, you walk right in\n", id)
+		}
+	}
+	return confirm(reader)
+}
+
+// confirm asks "Continue? (y/n)" and reports whether the player said yes
+func confirm(reader *bufio.Reader) bool {
+	fmt.Print("Continue? (y/n): ")
+	input, err := reader.ReadString('\n')
+	if err != nil {
+		return false
+	}
+	response := strings.TrimSpace(strings.ToLower(input))
+	return response == "y" || response == "yes"
+}
+
+// joinRoomIDs renders room IDs joined by sep
+func joinRoomIDs(ids []core.RoomID, sep string) string {
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		names[i] = string(id)
+	}
+	return strings.Join(names, sep)
+}
+
+// ResolveWithLogging applies the action, spends what it costs and streams the effects.
+// Illegal actions are returned as a *core.ActionError and cost nothing.
+func (g *GameManager) ResolveWithLogging(action core.Action) error {
 	// Create a fresh log for resolution
 	resolveLog := core.NewEffectLog()
 	
+	// Corrupted rooms can make a move cost more, so price it before applying
+	cost := core.ActionCost(g.state, action)
+	
 	// Apply the action with logging
-	newState := core.Apply(*g.state, action, resolveLog)
+	newState, err := core.ApplyChecked(*g.state, action, resolveLog)
+	if err != nil {
+		return err
+	}
+	newState.ActionsLeft -= cost
 	
 	// Update the game state
 	g.state = &newState
 	
+	g.record(func(j *core.Journal) error { return j.RecordAction(action, cost, g.state) })
+	
 	// Stream the effects if any occurred
 	if !resolveLog.IsEmpty() {
 		fmt.Println("\n— Resolve —")
-		resolveLog.StreamLines(1000 * time.Millisecond) // 1 second delay for clear line-by-line visibility
+		resolveLog.StreamLines(g.effectDelay)
 	}
+	return nil
 }
 
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spaceship/devesis/pkg/core"
+)
+
+// autosaveSlot is written at the end of every round and used by --resume
+const autosaveSlot = "autosave"
+
+// validSlotName keeps slot names safe to use as file names
+var validSlotName = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// SaveSlotMeta describes a save slot for the listing without decoding the game state
+type SaveSlotMeta struct {
+	Slot      string           `json:"slot"`
+	Round     int              `json:"round"`
+	Players   []SaveSlotPlayer `json:"players"`
+	Timestamp time.Time        `json:"timestamp"`
+}
+
+// SaveSlotPlayer is the per-developer summary shown in the slot listing
+type SaveSlotPlayer struct {
+	ID    core.PlayerID `json:"id"`
+	Class string        `json:"class"`
+	HP    uint8         `json:"hp"`
+	MaxHP uint8         `json:"max_hp"`
+}
+
+// saveSlotFile is the on-disk layout of a save slot
+type saveSlotFile struct {
+	Meta  SaveSlotMeta    `json:"meta"`
+	State json.RawMessage `json:"state"`
+}
+
+// savesDir returns the directory holding save slots under the user's config directory
+func savesDir() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	return filepath.Join(configDir, "devesis", "saves"), nil
+}
+
+// slotPath resolves the file for a slot, rejecting names that are not plain identifiers
+func slotPath(slot string) (string, error) {
+	if !validSlotName.MatchString(slot) {
+		return "", fmt.Errorf("invalid slot name %q (use letters, digits, - or _)", slot)
+	}
+	dir, err := savesDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, slot+".json"), nil
+}
+
+// writeSaveSlot serializes the game state and its metadata into a slot
+func writeSaveSlot(slot string, state *core.GameState) (SaveSlotMeta, error) {
+	path, err := slotPath(slot)
+	if err != nil {
+		return SaveSlotMeta{}, err
+	}
+
+	stateData, err := core.SaveGameState(state)
+	if err != nil {
+		return SaveSlotMeta{}, err
+	}
+
+	meta := SaveSlotMeta{
+		Slot:      slot,
+		Round:     state.Round,
+		Timestamp: time.Now(),
+	}
+	playerIDs := make([]string, 0, len(state.Players))
+	for id := range state.Players {
+		playerIDs = append(playerIDs, string(id))
+	}
+	sort.Strings(playerIDs)
+	for _, id := range playerIDs {
+		player := state.Players[core.PlayerID(id)]
+		meta.Players = append(meta.Players, SaveSlotPlayer{
+			ID:    player.ID,
+			Class: classDisplayName(player.Class),
+			HP:    player.HP,
+			MaxHP: player.MaxHP,
+		})
+	}
+
+	data, err := json.MarshalIndent(saveSlotFile{Meta: meta, State: stateData}, "", "  ")
+	if err != nil {
+		return SaveSlotMeta{}, fmt.Errorf("failed to encode save slot: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return SaveSlotMeta{}, fmt.Errorf("failed to create saves directory: %w", err)
+	}
+
+	// Write to a temp file first so a crash mid-write never corrupts an existing slot
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0o644); err != nil {
+		return SaveSlotMeta{}, fmt.Errorf("failed to write save slot: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return SaveSlotMeta{}, fmt.Errorf("failed to write save slot: %w", err)
+	}
+
+	return meta, nil
+}
+
+// readSaveSlot loads a game state and its metadata from a slot
+func readSaveSlot(slot string) (*core.GameState, SaveSlotMeta, error) {
+	path, err := slotPath(slot)
+	if err != nil {
+		return nil, SaveSlotMeta{}, err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, SaveSlotMeta{}, fmt.Errorf("no save in slot %q", slot)
+		}
+		return nil, SaveSlotMeta{}, fmt.Errorf("failed to read save slot: %w", err)
+	}
+
+	var file saveSlotFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, SaveSlotMeta{}, fmt.Errorf("failed to decode save slot %q: %w", slot, err)
+	}
+
+	state, info, err := core.LoadSave(file.State)
+	if err != nil {
+		return nil, SaveSlotMeta{}, err
+	}
+	if info.Migrated {
+		fmt.Printf("🔧 Upgraded save from format v%d to v%d.\n", info.Version, core.CurrentSaveVersion)
+	}
+	if info.CardDBChanged {
+		fmt.Println("🃏 Card database changed since this save - event cards refreshed from cards.yaml.")
+	}
+	state.ScratchLog = core.NewEffectLog()
+
+	return state, file.Meta, nil
+}
+
+// listSaveSlots returns metadata for every readable slot, newest first
+func listSaveSlots() ([]SaveSlotMeta, error) {
+	dir, err := savesDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list saves: %w", err)
+	}
+
+	var slots []SaveSlotMeta
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		var file saveSlotFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			continue // Skip unreadable slots rather than failing the whole listing
+		}
+		file.Meta.Slot = strings.TrimSuffix(name, ".json")
+		slots = append(slots, file.Meta)
+	}
+
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i].Timestamp.After(slots[j].Timestamp)
+	})
+	return slots, nil
+}
+
+// formatSlotPlayers renders the per-player summary of a slot listing line
+func formatSlotPlayers(players []SaveSlotPlayer) string {
+	parts := make([]string, 0, len(players))
+	for _, player := range players {
+		parts = append(parts, fmt.Sprintf("%s %s HP %d/%d", player.ID, player.Class, player.HP, player.MaxHP))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (g *GameManager) executeSave(args []string) error {
+	slot := "quicksave"
+	if len(args) > 0 {
+		slot = args[0]
+	}
+
+	meta, err := writeSaveSlot(slot, g.state)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("💾 Game saved to slot '%s' (round %d).\n", meta.Slot, meta.Round)
+	return nil
+}
+
+func (g *GameManager) executeLoad(args []string) error {
+	if g.dailyDate != "" {
+		return fmt.Errorf("loading saves is disabled during the daily challenge")
+	}
+
+	slot := "quicksave"
+	if len(args) > 0 {
+		slot = args[0]
+	}
+
+	state, meta, err := readSaveSlot(slot)
+	if err != nil {
+		return err
+	}
+	if err := loadGameMap(g.dataDir, state); err != nil {
+		return err
+	}
+
+	g.state = state
+	if g.profileNames != nil {
+		g.assignProfiles(g.playerNames) // The slot may hold a different team
+	}
+	g.record(func(j *core.Journal) error { return j.RecordStart(g.state) })
+	fmt.Printf("📂 Loaded slot '%s' (round %d, saved %s).\n",
+		slot, meta.Round, meta.Timestamp.Format("2006-01-02 15:04"))
+
+	// Signal the game loop to restart from the loaded state
+	return fmt.Errorf("load")
+}
+
+func (g *GameManager) showSaves() error {
+	slots, err := listSaveSlots()
+	if err != nil {
+		return err
+	}
+
+	if len(slots) == 0 {
+		fmt.Println("No saved games.")
+		return nil
+	}
+
+	fmt.Println("\nSaved games:")
+	for _, slot := range slots {
+		fmt.Printf("  %-12s Round %-2d  %s  (%s)\n",
+			slot.Slot, slot.Round, formatSlotPlayers(slot.Players), slot.Timestamp.Format("2006-01-02 15:04"))
+	}
+	return nil
+}
+
+// autosave writes the autosave slot, reporting but not failing on errors
+func (g *GameManager) autosave() {
+	if _, err := writeSaveSlot(autosaveSlot, g.state); err != nil {
+		fmt.Printf("⚠️ Autosave failed: %v\n", err)
+		return
+	}
+	fmt.Println("💾 Autosaved.")
+}
@@ -0,0 +1,256 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Reasons an action can be rejected. ApplyChecked wraps them in an
+// *ActionError, so callers match them with errors.Is.
+var (
+	ErrUnknownPlayer       = errors.New("unknown player")
+	ErrNotYourTurn         = errors.New("not this player's turn")
+	ErrNoActionsLeft       = errors.New("no actions remaining this turn")
+	ErrUnknownRoom         = errors.New("unknown room")
+	ErrNotAdjacent         = errors.New("room is not adjacent")
+	ErrDoorShut            = errors.New("door is shut")
+	ErrDoorOpen            = errors.New("door is already open")
+	ErrRoomAlreadySearched = errors.New("room already searched")
+	ErrNotEnoughAmmo       = errors.New("not enough ammo")
+	ErrNoTargets           = errors.New("no enemies in range")
+	ErrCardNotInHand       = errors.New("card not in hand")
+	ErrUnknownCard         = errors.New("unknown card")
+	ErrRoomActionUsed      = errors.New("room action already used this turn")
+	ErrNoRoomAction        = errors.New("no room action available here")
+	ErrQuestionPending     = errors.New("a coding question is waiting for an answer")
+	ErrNoPendingQuestion   = errors.New("no question to answer")
+	ErrInvalidChoice       = errors.New("invalid answer choice")
+	ErrNoQuestionSkips     = errors.New("no question skips left")
+	ErrNoDestination       = errors.New("card needs a destination room")
+	ErrOutOfReach          = errors.New("room is out of reach")
+	ErrUnsupportedAction   = errors.New("unsupported action")
+)
+
+// ActionError reports why an action was rejected
+type ActionError struct {
+	Action   string   // Action type name, e.g. "MoveAction"
+	PlayerID PlayerID // Acting player, empty for game-level actions
+	Reason   error    // One of the Err* sentinels above
+	Detail   string   // Human-readable explanation
+}
+
+func (e *ActionError) Error() string {
+	if e.Detail != "" {
+		return e.Detail
+	}
+	return e.Reason.Error()
+}
+
+func (e *ActionError) Unwrap() error {
+	return e.Reason
+}
+
+// ApplyChecked is Apply for frontends: it validates the action first and, if
+// the action is illegal, returns the state unchanged with an *ActionError.
+// Spending the action from ActionsLeft is still up to the caller.
+func ApplyChecked(state GameState, action Action, log *EffectLog) (GameState, error) {
+	if err := ValidateAction(&state, action); err != nil {
+		return state, err
+	}
+	return Apply(state, action, log), nil
+}
+
+// ValidateAction reports whether action is legal in state without applying it
+func ValidateAction(state *GameState, action Action) error {
+	switch a := action.(type) {
+	case InitializeGameAction:
+		return nil
+
+	case GiveSpecialCardAction:
+		if _, exists := state.Players[a.PlayerID]; !exists {
+			return rejectAction(action, a.PlayerID, ErrUnknownPlayer, "unknown player %s", a.PlayerID)
+		}
+		return nil
+
+	case PassAction:
+		_, err := actingPlayer(state, action, a.PlayerID, false)
+		return err
+
+	case AnswerQuestionAction:
+		pending := state.PendingQuestion
+		if pending == nil || pending.PlayerID != a.PlayerID {
+			return rejectAction(action, a.PlayerID, ErrNoPendingQuestion, "%s has no question to answer", a.PlayerID)
+		}
+		question, _ := CurrentQuestion(state)
+		if err := validateResponse(question, a); err != nil {
+			return rejectAction(action, a.PlayerID, ErrInvalidChoice, "%v", err)
+		}
+		return nil
+
+	case SkipQuestionAction:
+		pending := state.PendingQuestion
+		if pending == nil || pending.PlayerID != a.PlayerID {
+			return rejectAction(action, a.PlayerID, ErrNoPendingQuestion, "%s has no question to skip", a.PlayerID)
+		}
+		if player := state.Players[a.PlayerID]; player == nil || player.QuestionSkips <= 0 {
+			return rejectAction(action, a.PlayerID, ErrNoQuestionSkips, "%s has no question skips left", a.PlayerID)
+		}
+		return nil
+
+	case MoveAction:
+		player, err := actingPlayer(state, action, a.PlayerID, true)
+		if err != nil {
+			return err
+		}
+		if _, exists := state.Rooms[a.To]; !exists {
+			return rejectAction(action, a.PlayerID, ErrUnknownRoom, "unknown room %s", a.To)
+		}
+		if a.To == player.Location || !containsRoom(GetAdjacentRooms(player.Location), a.To) {
+			return rejectAction(action, a.PlayerID, ErrNotAdjacent, "cannot move to %s (not adjacent to %s)", a.To, player.Location)
+		}
+		if door := DoorBetween(state, player.Location, a.To); door.shut() {
+			return rejectAction(action, a.PlayerID, ErrDoorShut, "the door to %s is %s - open it first", a.To, door)
+		}
+		return nil
+
+	case OpenDoorAction:
+		player, err := actingPlayer(state, action, a.PlayerID, true)
+		if err != nil {
+			return err
+		}
+		if _, exists := state.Rooms[a.To]; !exists {
+			return rejectAction(action, a.PlayerID, ErrUnknownRoom, "unknown room %s", a.To)
+		}
+		if a.To == player.Location || !containsRoom(GetAdjacentRooms(player.Location), a.To) {
+			return rejectAction(action, a.PlayerID, ErrNotAdjacent, "no door to %s (not adjacent to %s)", a.To, player.Location)
+		}
+		if !DoorBetween(state, player.Location, a.To).shut() {
+			return rejectAction(action, a.PlayerID, ErrDoorOpen, "the door to %s is already open", a.To)
+		}
+		return nil
+
+	case SearchAction:
+		player, err := actingPlayer(state, action, a.PlayerID, true)
+		if err != nil {
+			return err
+		}
+		if room := state.Rooms[player.Location]; room != nil && room.Searched {
+			return rejectAction(action, a.PlayerID, ErrRoomAlreadySearched, "%s has already been searched", player.Location)
+		}
+		return nil
+
+	case ShootAction:
+		player, err := actingPlayer(state, action, a.PlayerID, true)
+		if err != nil {
+			return err
+		}
+		if player.Ammo < ShootAmmoCost {
+			return rejectAction(action, a.PlayerID, ErrNotEnoughAmmo, "not enough ammo: need %d, have %d", ShootAmmoCost, player.Ammo)
+		}
+		if !enemyInAny(state, GetAdjacentRooms(player.Location)) {
+			return rejectAction(action, a.PlayerID, ErrNoTargets, "no enemies in rooms adjacent to %s", player.Location)
+		}
+		return nil
+
+	case MeleeAction:
+		player, err := actingPlayer(state, action, a.PlayerID, true)
+		if err != nil {
+			return err
+		}
+		if !enemyInAny(state, []RoomID{player.Location}) {
+			return rejectAction(action, a.PlayerID, ErrNoTargets, "no enemies in %s", player.Location)
+		}
+		return nil
+
+	case PlayCardAction:
+		player, err := actingPlayer(state, action, a.PlayerID, true)
+		if err != nil {
+			return err
+		}
+		if !containsCard(player.Hand, a.CardID) {
+			return rejectAction(action, a.PlayerID, ErrCardNotInHand, "card %s is not in %s's hand", a.CardID, a.PlayerID)
+		}
+		card, exists := CardDB[a.CardID]
+		if !exists {
+			return rejectAction(action, a.PlayerID, ErrUnknownCard, "unknown card %s", a.CardID)
+		}
+		if steps := SprintSteps(card); steps > 0 {
+			if a.Target == "" {
+				return rejectAction(action, a.PlayerID, ErrNoDestination, "%s needs a destination room", card.Name)
+			}
+			if _, exists := state.Rooms[a.Target]; !exists {
+				return rejectAction(action, a.PlayerID, ErrUnknownRoom, "unknown room %s", a.Target)
+			}
+			if !PlanSprint(state, a.PlayerID, a.Target, steps).Valid {
+				return rejectAction(action, a.PlayerID, ErrOutOfReach, "%s is not within %d rooms of %s without crossing corruption", a.Target, steps, player.Location)
+			}
+		}
+		return nil
+
+	case RoomAction:
+		player, err := actingPlayer(state, action, a.PlayerID, true)
+		if err != nil {
+			return err
+		}
+		if player.SpecialUsed {
+			return rejectAction(action, a.PlayerID, ErrRoomActionUsed, "room action already used this turn")
+		}
+		room := state.Rooms[player.Location]
+		if room == nil || (room.Type != MedBay && room.Type != AmmoCache && room.Type != CleanRoomType) {
+			return rejectAction(action, a.PlayerID, ErrNoRoomAction, "no room action available in %s", player.Location)
+		}
+		return nil
+	}
+
+	return rejectAction(action, "", ErrUnsupportedAction, "unsupported action %T", action)
+}
+
+// actingPlayer checks the player exists, is the active player, is not in the
+// middle of answering a question and, for actions that cost an action, still
+// has one left.
+func actingPlayer(state *GameState, action Action, id PlayerID, costsAction bool) (*PlayerState, error) {
+	player, exists := state.Players[id]
+	if !exists {
+		return nil, rejectAction(action, id, ErrUnknownPlayer, "unknown player %s", id)
+	}
+	if state.ActivePlayer != "" && state.ActivePlayer != id {
+		return nil, rejectAction(action, id, ErrNotYourTurn, "it is %s's turn, not %s's", state.ActivePlayer, id)
+	}
+	if state.PendingQuestion != nil {
+		return nil, rejectAction(action, id, ErrQuestionPending, "answer the question for %s first", state.PendingQuestion.To)
+	}
+	if costsAction && state.ActionsLeft <= 0 {
+		return nil, rejectAction(action, id, ErrNoActionsLeft, "no actions remaining this turn")
+	}
+	return player, nil
+}
+
+func rejectAction(action Action, id PlayerID, reason error, format string, args ...any) *ActionError {
+	return &ActionError{
+		Action:   actionName(action),
+		PlayerID: id,
+		Reason:   reason,
+		Detail:   fmt.Sprintf(format, args...),
+	}
+}
+
+// enemyInAny reports whether any enemy stands in one of the rooms
+func enemyInAny(state *GameState, rooms []RoomID) bool {
+	for _, enemy := range state.Enemies {
+		for _, roomID := range rooms {
+			if enemy.Location == roomID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsCard(hand []CardID, cardID CardID) bool {
+	for _, id := range hand {
+		if id == cardID {
+			return true
+		}
+	}
+	return false
+}
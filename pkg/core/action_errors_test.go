@@ -0,0 +1,113 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// checkedTestState puts P1 in R12 with every room on the map, so adjacency
+// follows the real layout.
+func checkedTestState() GameState {
+	state := GameState{
+		ActivePlayer: "P1",
+		ActionsLeft:  2,
+		Rooms:        map[RoomID]*RoomState{},
+		Enemies:      map[EnemyID]*Enemy{},
+		Players: map[PlayerID]*PlayerState{
+			"P1": {ID: "P1", Location: "R12", HP: 5, MaxHP: 5, Ammo: 1, MaxAmmo: 3, Damage: BasicDamage, Hand: []CardID{"C1"}},
+			"P2": {ID: "P2", Location: "R12", HP: 5, MaxHP: 5},
+		},
+	}
+	for id := range ROOM_POSITIONS {
+		state.Rooms[RoomID(id)] = &RoomState{ID: RoomID(id), Type: Empty}
+	}
+	return state
+}
+
+func TestApplyCheckedRejections(t *testing.T) {
+	previousCards := CardDB
+	t.Cleanup(func() { CardDB = previousCards })
+	CardDB = map[CardID]Card{"C1": {ID: "C1", Name: "Test Card"}}
+
+	adjacent := GetAdjacentRooms("R12")[0]
+	farRoom := RoomID("R01")
+
+	tests := []struct {
+		name   string
+		setup  func(*GameState)
+		action Action
+		want   error
+	}{
+		{"unknown player", nil, SearchAction{PlayerID: "P9"}, ErrUnknownPlayer},
+		{"not your turn", nil, SearchAction{PlayerID: "P2"}, ErrNotYourTurn},
+		{"no actions left", func(s *GameState) { s.ActionsLeft = 0 }, SearchAction{PlayerID: "P1"}, ErrNoActionsLeft},
+		{"unknown room", nil, MoveAction{PlayerID: "P1", To: "R99"}, ErrUnknownRoom},
+		{"not adjacent", nil, MoveAction{PlayerID: "P1", To: farRoom}, ErrNotAdjacent},
+		{"same room", nil, MoveAction{PlayerID: "P1", To: "R12"}, ErrNotAdjacent},
+		{"already searched", func(s *GameState) { s.Rooms["R12"].Searched = true }, SearchAction{PlayerID: "P1"}, ErrRoomAlreadySearched},
+		{"no ammo", func(s *GameState) { s.Players["P1"].Ammo = 0 }, ShootAction{PlayerID: "P1"}, ErrNotEnoughAmmo},
+		{"nothing to shoot", nil, ShootAction{PlayerID: "P1"}, ErrNoTargets},
+		{"nothing to melee", nil, MeleeAction{PlayerID: "P1"}, ErrNoTargets},
+		{"card not in hand", nil, PlayCardAction{PlayerID: "P1", CardID: "C2"}, ErrCardNotInHand},
+		{"unknown card", func(s *GameState) { s.Players["P1"].Hand = []CardID{"C2"} }, PlayCardAction{PlayerID: "P1", CardID: "C2"}, ErrUnknownCard},
+		{"room action used", func(s *GameState) { s.Players["P1"].SpecialUsed = true }, RoomAction{PlayerID: "P1"}, ErrRoomActionUsed},
+		{"no room action", nil, RoomAction{PlayerID: "P1"}, ErrNoRoomAction},
+		{"unsupported", nil, SpecialAction{PlayerID: "P1"}, ErrUnsupportedAction},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := checkedTestState()
+			if tt.setup != nil {
+				tt.setup(&state)
+			}
+			if tt.name == "not adjacent" && CanMove(&state, "R12", farRoom) {
+				t.Fatalf("test assumes %s is not adjacent to R12", farRoom)
+			}
+			before, _ := SaveGameState(&state)
+
+			got, err := ApplyChecked(state, tt.action, NewEffectLog())
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+			var actionErr *ActionError
+			if !errors.As(err, &actionErr) {
+				t.Fatalf("expected an *ActionError, got %T", err)
+			}
+			if actionErr.Action == "" || actionErr.Error() == "" {
+				t.Errorf("expected action name and detail, got %+v", actionErr)
+			}
+			after, _ := SaveGameState(&got)
+			if !bytes.Equal(before, after) {
+				t.Error("rejected action changed the state")
+			}
+		})
+	}
+
+	t.Run("legal move", func(t *testing.T) {
+		got, err := ApplyChecked(checkedTestState(), MoveAction{PlayerID: "P1", To: adjacent}, NewEffectLog())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Players["P1"].Location != adjacent {
+			t.Errorf("expected P1 in %s, got %s", adjacent, got.Players["P1"].Location)
+		}
+	})
+
+	t.Run("legal melee", func(t *testing.T) {
+		state := checkedTestState()
+		state.Enemies["E1"] = &Enemy{ID: "E1", Type: InfiniteLoop, HP: 3, MaxHP: 3, Location: "R12"}
+		if _, err := ApplyChecked(state, MeleeAction{PlayerID: "P1"}, NewEffectLog()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("pass with no actions left", func(t *testing.T) {
+		state := checkedTestState()
+		state.ActionsLeft = 0
+		if _, err := ApplyChecked(state, PassAction{PlayerID: "P1"}, NewEffectLog()); err != nil {
+			t.Fatalf("pass should always be legal for the active player, got %v", err)
+		}
+	})
+}
@@ -11,6 +11,36 @@ type MoveAction struct {
 
 func (MoveAction) isAction() {}
 
+// AnswerQuestionAction answers the pending question of a move into an
+// unexplored room. Choice is the 0-based option index for choice questions,
+// Choices the selected option indexes for multi questions and Text the typed
+// answer for text questions.
+type AnswerQuestionAction struct {
+	PlayerID PlayerID
+	Choice   int
+	Choices  []int  `json:",omitempty"`
+	Text     string `json:",omitempty"`
+}
+
+func (AnswerQuestionAction) isAction() {}
+
+// SkipQuestionAction spends one of the player's question skips on the pending
+// question: the move goes ahead without a reward or a penalty
+type SkipQuestionAction struct {
+	PlayerID PlayerID
+}
+
+func (SkipQuestionAction) isAction() {}
+
+// OpenDoorAction opens the shut door between the player's room and a
+// neighbouring one. A locked door takes two: the first unlocks it.
+type OpenDoorAction struct {
+	PlayerID PlayerID
+	To       RoomID
+}
+
+func (OpenDoorAction) isAction() {}
+
 type SearchAction struct {
 	PlayerID PlayerID
 }
@@ -46,6 +76,7 @@ func (SpecialAction) isAction() {}
 type PlayCardAction struct {
 	PlayerID PlayerID
 	CardID   CardID
+	Target   RoomID `json:",omitempty"` // Destination for movement cards (see SprintSteps)
 }
 
 func (PlayCardAction) isAction() {}
@@ -57,8 +88,10 @@ type PassAction struct {
 func (PassAction) isAction() {}
 
 type InitializeGameAction struct {
-	Seed        int64
-	PlayerClass DevClass
+	Seed          int64
+	Difficulty    Difficulty // Empty means Normal
+	PlayerClass   DevClass   // Solo game class (used when PlayerClasses is empty)
+	PlayerClasses []DevClass // One class per player for hot-seat games (P1, P2, ...)
 }
 
 func (InitializeGameAction) isAction() {}
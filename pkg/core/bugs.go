@@ -1,7 +1,7 @@
 package core
 
 // PlaceBugs adds bug markers to rooms based on game events
-func PlaceBugs(state *GameState, count uint8) {
+func PlaceBugs(state *GameState, count uint8, log *EffectLog) {
 	if count == 0 {
 		return
 	}
@@ -45,7 +45,7 @@ func PlaceBugs(state *GameState, count uint8) {
 	
 	// Handle spawning for corrupted rooms that received bugs
 	if len(spawnCheckRooms) > 0 {
-		SpawnEnemiesForCorruptedRooms(state, spawnCheckRooms)
+		SpawnEnemiesForCorruptedRooms(state, spawnCheckRooms, log)
 	}
 }
 
@@ -62,7 +62,8 @@ func CheckOutOfRAMCondition(state *GameState) bool {
 
 func getValidRoomsForBugs(state *GameState) []RoomID {
 	var valid []RoomID
-	for id, room := range state.Rooms {
+	for _, id := range sortedRoomIDs(state) {
+		room := state.Rooms[id]
 		// Can place bugs in any room that's not out of RAM
 		// Corrupted rooms can still receive bugs (which triggers spawns)
 		if !room.OutOfRam {
@@ -73,9 +74,9 @@ func getValidRoomsForBugs(state *GameState) []RoomID {
 }
 
 // SpawnEnemiesForCorruptedRooms handles enemy spawning when bugs are added to corrupted rooms
-func SpawnEnemiesForCorruptedRooms(state *GameState, roomIDs []RoomID) {
+func SpawnEnemiesForCorruptedRooms(state *GameState, roomIDs []RoomID, log *EffectLog) {
 	for _, roomID := range roomIDs {
-		SpawnEnemyFromBag(state, roomID)
+		SpawnEnemyFromBag(state, roomID, log)
 		// If bag is empty, stop spawning
 		if IsSpawnBagEmpty(state) {
 			break
@@ -97,7 +98,7 @@ func UpdateRoomCorruption(state *GameState) {
 }
 
 // PlaceBugsInSpecificRooms adds bugs to specific rooms and handles spawning
-func PlaceBugsInSpecificRooms(state *GameState, roomIDs []RoomID) {
+func PlaceBugsInSpecificRooms(state *GameState, roomIDs []RoomID, bugs uint8, log *EffectLog) {
 	var spawnCheckRooms []RoomID
 	
 	for _, roomID := range roomIDs {
@@ -109,8 +110,8 @@ func PlaceBugsInSpecificRooms(state *GameState, roomIDs []RoomID) {
 		// Check if room is corrupted before adding bug
 		wasCorruptedBefore := room.Corrupted
 		
-		// Add 2 bug markers (max 9) - increased penalty for wrong answers
-		bugsToAdd := uint8(2)
+		// Add bug markers (max 9)
+		bugsToAdd := bugs
 		if room.BugMarkers + bugsToAdd > MaxBugMarkers {
 			bugsToAdd = MaxBugMarkers - room.BugMarkers
 		}
@@ -131,22 +132,28 @@ func PlaceBugsInSpecificRooms(state *GameState, roomIDs []RoomID) {
 	
 	// Handle spawning for corrupted rooms that received bugs
 	if len(spawnCheckRooms) > 0 {
-		SpawnEnemiesForCorruptedRooms(state, spawnCheckRooms)
+		SpawnEnemiesForCorruptedRooms(state, spawnCheckRooms, log)
 	}
 }
 
-// ApplyWrongAnswerPenalties handles all penalties for incorrect movement questions
-func ApplyWrongAnswerPenalties(state *GameState, targetRoom RoomID) {
-	// Add bugs to target room and all adjacent rooms
+// WrongAnswerBugRooms lists the rooms that get bugs when a question of the
+// given difficulty is missed: only the target room for easy questions, the
+// target room and its neighbours otherwise
+func WrongAnswerBugRooms(targetRoom RoomID, difficulty Difficulty) []RoomID {
 	roomsToInfect := []RoomID{targetRoom}
-	adjacent := GetAdjacentRooms(targetRoom)
-	roomsToInfect = append(roomsToInfect, adjacent...)
-	
+	if difficulty != Easy {
+		roomsToInfect = append(roomsToInfect, GetAdjacentRooms(targetRoom)...)
+	}
+	return roomsToInfect
+}
+
+// ApplyWrongAnswerPenalties handles all penalties for incorrect movement questions
+func ApplyWrongAnswerPenalties(state *GameState, targetRoom RoomID, difficulty Difficulty, log *EffectLog) {
 	// Use proper bug placement (respects limits, handles corruption, triggers spawns)
-	PlaceBugsInSpecificRooms(state, roomsToInfect)
+	PlaceBugsInSpecificRooms(state, WrongAnswerBugRooms(targetRoom, difficulty), WrongAnswerBugs, log)
 	
 	// Drop all cards from active player's hand to discard pile
 	if player := GetActivePlayer(state); player != nil {
 		moveAllCards(&player.Hand, &player.Discard)
 	}
-}
\ No newline at end of file
+}
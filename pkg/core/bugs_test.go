@@ -24,7 +24,7 @@ func TestPlaceBugs_RespectsMaxLimit(t *testing.T) {
 	}
 	
 	// Try to add 5 bugs (should cap at 9)
-	PlaceBugs(&state, 5)
+	PlaceBugs(&state, 5, NewEffectLog())
 	
 	// Should have exactly 9 bugs (not 13)
 	if state.Rooms["R01"].BugMarkers != MaxBugMarkers {
@@ -52,7 +52,7 @@ func TestPlaceBugs_AutoCorruption(t *testing.T) {
 	}
 	
 	// Add 2 bugs (should trigger corruption at 3+)
-	PlaceBugs(&state, 2)
+	PlaceBugs(&state, 2, NewEffectLog())
 	
 	// Should be corrupted now
 	if !state.Rooms["R01"].Corrupted {
@@ -77,7 +77,7 @@ func TestSpawnEnemiesForCorruptedRooms_DrawsFromBag(t *testing.T) {
 	}
 	
 	// Spawn enemy for room R01
-	SpawnEnemiesForCorruptedRooms(&state, []RoomID{"R01"})
+	SpawnEnemiesForCorruptedRooms(&state, []RoomID{"R01"}, NewEffectLog())
 	
 	// Should have created one enemy
 	if len(state.Enemies) != 1 {
@@ -106,7 +106,7 @@ func TestSpawnEnemiesForCorruptedRooms_EmptyBag(t *testing.T) {
 	}
 	
 	// Try to spawn (should do nothing)
-	SpawnEnemiesForCorruptedRooms(&state, []RoomID{"R01"})
+	SpawnEnemiesForCorruptedRooms(&state, []RoomID{"R01"}, NewEffectLog())
 	
 	// Should have no enemies
 	if len(state.Enemies) != 0 {
@@ -139,7 +139,7 @@ func TestUpdateRoomCorruption_AutoCorruptAndUncorrupt(t *testing.T) {
 }
 
 func TestInitializeSpawnBag_CorrectDistribution(t *testing.T) {
-	bag := initializeSpawnBag()
+	bag := initializeSpawnBag(Normal)
 	
 	// Count enemy types
 	loops := 0
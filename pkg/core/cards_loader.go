@@ -130,6 +130,7 @@ func convertYAMLToCard(yamlCard YAMLCard) (Card, error) {
 		Name:    yamlCard.Name,
 		Description: yamlCard.Desc,
 		Source:  source,
+		Rarity:  yamlCard.Rarity,
 		Effects: effectsList,
 	}
 
@@ -161,6 +162,16 @@ func stringToEffectOp(s string) (EffectOp, error) {
 		return SpawnEnemy, nil
 	case "MoveEnemies":
 		return MoveEnemies, nil
+	case "SkipQuestion":
+		return SkipQuestion, nil
+	case "CloseDoors":
+		return CloseDoors, nil
+	case "LockDoors":
+		return LockDoors, nil
+	case "BreakDoors":
+		return BreakDoors, nil
+	case "MoveSelf":
+		return MoveSelf, nil
 	default:
 		return 0, fmt.Errorf("unknown effect op: %s", s)
 	}
@@ -43,12 +43,14 @@ func applyShootAction(state *GameState, action ShootAction, log *EffectLog) {
 	oldAmmo := player.Ammo
 	player.Ammo -= ShootAmmoCost
 	log.Add("🔫 %s shoots! Ammo: %d → %d", action.PlayerID, oldAmmo, player.Ammo)
+	makeNoise(state, player.Location, ActionNoise.Shoot)
 	
 	// Get adjacent rooms
 	adjacentRooms := GetAdjacentRooms(player.Location)
 	
 	// Damage all enemies in adjacent rooms
-	for _, enemy := range state.Enemies {
+	for _, enemyID := range sortedEnemyIDs(state) {
+		enemy := state.Enemies[enemyID]
 		for _, roomID := range adjacentRooms {
 			if enemy.Location == roomID {
 				oldHP := enemy.HP
@@ -61,6 +63,10 @@ func applyShootAction(state *GameState, action ShootAction, log *EffectLog) {
 				if oldHP != enemy.HP {
 					log.Add("💥 Hit %s in %s! HP: %d → %d", getEnemyDisplayName(enemy.Type), roomID, oldHP, enemy.HP)
 				}
+				if oldHP > 0 && enemy.HP == 0 {
+					recordKill(player, enemy.Type)
+				}
+				enemyHit(state, enemy, oldHP, log)
 				break
 			}
 		}
@@ -74,9 +80,11 @@ func applyMeleeAction(state *GameState, action MeleeAction, log *EffectLog) {
 	}
 	
 	log.Add("⚔️ %s attacks with melee!", action.PlayerID)
+	makeNoise(state, player.Location, ActionNoise.Melee)
 	
 	// Damage all enemies in same room (no ammo cost)
-	for _, enemy := range state.Enemies {
+	for _, enemyID := range sortedEnemyIDs(state) {
+		enemy := state.Enemies[enemyID]
 		if enemy.Location == player.Location {
 			oldHP := enemy.HP
 			damage := player.Damage
@@ -88,6 +96,10 @@ func applyMeleeAction(state *GameState, action MeleeAction, log *EffectLog) {
 			if oldHP != enemy.HP {
 				log.Add("💥 Hit %s! HP: %d → %d", getEnemyDisplayName(enemy.Type), oldHP, enemy.HP)
 			}
+			if oldHP > 0 && enemy.HP == 0 {
+				recordKill(player, enemy.Type)
+			}
+			enemyHit(state, enemy, oldHP, log)
 		}
 	}
 }
@@ -0,0 +1,152 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+// newCorruptionTestGameState has P1 in R12 next to an explored, corrupted R07
+func newCorruptionTestGameState(actionsLeft int) GameState {
+	state := newQuestionTestGameState()
+	state.ActionsLeft = actionsLeft
+	state.Rooms["R07"].Explored = true
+	state.Rooms["R07"].Corrupted = true
+	return state
+}
+
+// moveOption returns the LegalActions option for moving P1 to a room
+func moveOption(t *testing.T, state *GameState, to RoomID) ActionOption {
+	t.Helper()
+	for _, option := range LegalActions(state) {
+		if move, ok := option.Action.(MoveAction); ok && move.To == to {
+			return option
+		}
+	}
+	t.Fatalf("expected a move to %s among the legal actions", to)
+	return ActionOption{}
+}
+
+func TestCorruptedRoomCostsExtraAction(t *testing.T) {
+	withTestQuestions(t)
+	state := newCorruptionTestGameState(2)
+	move := MoveAction{PlayerID: "P1", To: "R07"}
+
+	if tax := CorruptionTaxFor(&state, move); tax != ExtraActionTax {
+		t.Fatalf("expected the extra action tax, got %v", tax)
+	}
+	if cost := ActionCost(&state, move); cost != 2 {
+		t.Errorf("expected the move to cost 2 actions, got %d", cost)
+	}
+	if option := moveOption(t, &state, "R07"); option.Cost != 2 || option.NeedsQuestion {
+		t.Errorf("expected a 2-action move without a question, got %+v", option)
+	}
+
+	log := NewEffectLog()
+	state = Apply(state, move, log)
+	if state.Players["P1"].Location != "R07" || state.PendingQuestion != nil {
+		t.Errorf("expected P1 straight into R07, got %s with %+v", state.Players["P1"].Location, state.PendingQuestion)
+	}
+	if !strings.Contains(strings.Join(log.Lines, "\n"), "costs an extra action") {
+		t.Errorf("expected the toll to be logged, got %v", log.Lines)
+	}
+}
+
+func TestCorruptedRoomOnLastActionAsksQuestion(t *testing.T) {
+	withTestCardDB(t, Card{ID: "SPECIAL_TEST", Name: "Test Special", Source: SrcSpecial})
+	withTestQuestions(t)
+	state := newCorruptionTestGameState(1)
+	move := MoveAction{PlayerID: "P1", To: "R07"}
+
+	if tax := CorruptionTaxFor(&state, move); tax != QuestionTax {
+		t.Fatalf("expected the question tax, got %v", tax)
+	}
+	if option := moveOption(t, &state, "R07"); option.Cost != 1 || !option.NeedsQuestion {
+		t.Errorf("expected a 1-action move with a question, got %+v", option)
+	}
+
+	state = Apply(state, move, NewEffectLog())
+	want := PendingQuestion{PlayerID: "P1", To: "R07", QuestionID: 3, Corrupted: true}
+	if state.PendingQuestion == nil || *state.PendingQuestion != want {
+		t.Fatalf("expected pending question %+v, got %+v", want, state.PendingQuestion)
+	}
+
+	// A wrong answer keeps P1 out without the usual penalties
+	wrong := Apply(state, scriptedAnswer(QuestionDB[3], "P1", false), NewEffectLog())
+	player := wrong.Players["P1"]
+	if player.Location != "R12" || len(player.Hand) != 1 || wrong.Rooms["R07"].BugMarkers != 0 {
+		t.Errorf("expected P1 kept in R12 with hand and bugs untouched, got %s hand %v bugs %d",
+			player.Location, player.Hand, wrong.Rooms["R07"].BugMarkers)
+	}
+	if wrong.PendingQuestion != nil || len(wrong.AnswerHistory) != 1 || wrong.AnswerHistory[0].Correct {
+		t.Errorf("expected the wrong answer recorded and cleared, got %+v", wrong.AnswerHistory)
+	}
+
+	// A right answer gets P1 in, with no card as a reward
+	right := Apply(state, AnswerQuestionAction{PlayerID: "P1", Choice: QuestionDB[3].CorrectAnswer}, NewEffectLog())
+	player = right.Players["P1"]
+	if player.Location != "R07" || len(player.Hand) != 1 {
+		t.Errorf("expected P1 in R07 with no reward, got %s hand %v", player.Location, player.Hand)
+	}
+
+	// Once the questions run out the way is free
+	state = newCorruptionTestGameState(1)
+	state.NextQuestion = len(state.QuestionOrder)
+	if tax := CorruptionTaxFor(&state, move); tax != NoTax {
+		t.Errorf("expected no tax with the question bank exhausted, got %v", tax)
+	}
+}
+
+// corruptionMap is a 2×3 ship with a corrupted B between A and C:
+//
+//	A B C
+//	D E F
+const corruptionMap = `
+grid: {rows: 2, cols: 3}
+rooms:
+  - {id: A, row: 0, col: 0}
+  - {id: B, row: 0, col: 1}
+  - {id: C, row: 0, col: 2}
+  - {id: D, row: 1, col: 0}
+  - {id: E, row: 1, col: 1}
+  - {id: F, row: 1, col: 2}
+roles:
+  start: C
+  key: D
+  engines: [E]
+  escapes: [F]
+pool:
+  - {type: MedBay, count: 2}
+`
+
+func TestEnemiesPathByCorruption(t *testing.T) {
+	withTestMap(t, corruptionMap)
+	move := Effect{Op: MoveEnemies, Scope: AllRooms, N: 1}
+
+	tests := []struct {
+		enemy EnemyType
+		want  RoomID
+	}{
+		{InfiniteLoop, "B"},  // Straight through the corruption
+		{StackOverflow, "D"}, // The long way round
+	}
+	for _, tt := range tests {
+		state := Apply(GameState{}, InitializeGameAction{Seed: 1, PlayerClasses: []DevClass{Backend}}, NewEffectLog())
+		state.Rooms["B"].Corrupted = true
+		state.Enemies = map[EnemyID]*Enemy{"E1": {ID: "E1", Type: tt.enemy, HP: 1, MaxHP: 1, Damage: 1, Location: "A"}}
+
+		ApplyMoveEnemies(&state, move, "", NewEffectLog())
+		if loc := state.Enemies["E1"].Location; loc != tt.want {
+			t.Errorf("%s: expected a move to %s, got %s", getEnemyDisplayName(tt.enemy), tt.want, loc)
+		}
+	}
+
+	// With no way round, a Stack Overflow goes through anyway
+	withTestMap(t, strings.Replace(corruptionMap, "pool:", "walls:\n  - [A, D]\npool:", 1))
+	state := Apply(GameState{}, InitializeGameAction{Seed: 1, PlayerClasses: []DevClass{Backend}}, NewEffectLog())
+	state.Rooms["B"].Corrupted = true
+	state.Enemies = map[EnemyID]*Enemy{"E1": {ID: "E1", Type: StackOverflow, HP: 3, MaxHP: 3, Damage: 1, Location: "A"}}
+	ApplyMoveEnemies(&state, move, "", NewEffectLog())
+	if loc := state.Enemies["E1"].Location; loc != "B" {
+		t.Errorf("expected the Stack Overflow through B when walled in, got %s", loc)
+	}
+}
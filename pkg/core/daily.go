@@ -0,0 +1,73 @@
+package core
+
+import (
+	"hash/fnv"
+	"strings"
+)
+
+// DailySeed derives the shared seed for a daily challenge from the calendar
+// date (YYYY-MM-DD) and class, so everyone playing that class that day gets
+// the same ship.
+func DailySeed(date string, class DevClass) int64 {
+	name := ""
+	for _, option := range GetAvailableClasses() {
+		if option.Class == class {
+			name = strings.ToLower(option.DisplayName)
+		}
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte("devesis-daily:" + date + ":" + name))
+	return int64(h.Sum64() &^ (1 << 63)) // Keep seeds positive so they read well on screen
+}
+
+// RunSummary is the score of a finished run, used to compare runs on the same seed
+type RunSummary struct {
+	Win               bool `json:"win"`
+	Rounds            int  `json:"rounds"`
+	HP                int  `json:"hp"`
+	Kills             int  `json:"kills"`
+	QuestionsAnswered int  `json:"questions_answered"`
+}
+
+// SummarizeRun scores a finished game. Rounds counts completed rounds, since the
+// end check runs after round maintenance has advanced the round counter.
+// Questions count only when answered, not when skipped.
+func SummarizeRun(state *GameState, win bool) RunSummary {
+	summary := RunSummary{
+		Win:               win,
+		Rounds:            state.Round - 1,
+		QuestionsAnswered: len(state.AnswerHistory),
+	}
+	if summary.Rounds < 1 {
+		summary.Rounds = 1
+	}
+	for _, player := range state.Players {
+		summary.HP += int(player.HP)
+		for _, count := range player.Kills {
+			summary.Kills += count
+		}
+	}
+	return summary
+}
+
+// BetterRun reports whether a ranks above b: wins first, then fewer rounds,
+// more HP left, more kills and more questions answered.
+func BetterRun(a, b RunSummary) bool {
+	if a.Win != b.Win {
+		return a.Win
+	}
+	if a.Rounds != b.Rounds {
+		if a.Win {
+			return a.Rounds < b.Rounds // Faster escapes rank higher
+		}
+		return a.Rounds > b.Rounds // Surviving longer ranks higher
+	}
+	if a.HP != b.HP {
+		return a.HP > b.HP
+	}
+	if a.Kills != b.Kills {
+		return a.Kills > b.Kills
+	}
+	return a.QuestionsAnswered > b.QuestionsAnswered
+}
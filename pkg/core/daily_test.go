@@ -0,0 +1,63 @@
+package core
+
+import "testing"
+
+func TestDailySeedIsSharedPerDateAndClass(t *testing.T) {
+	if DailySeed("2026-10-17", Backend) != DailySeed("2026-10-17", Backend) {
+		t.Error("expected the same date and class to give the same seed")
+	}
+	if DailySeed("2026-10-17", Backend) == DailySeed("2026-10-18", Backend) {
+		t.Error("expected different dates to give different seeds")
+	}
+	if DailySeed("2026-10-17", Backend) == DailySeed("2026-10-17", Frontend) {
+		t.Error("expected different classes to give different seeds")
+	}
+	if seed := DailySeed("2026-10-17", DevOps); seed <= 0 {
+		t.Errorf("expected a positive seed, got %d", seed)
+	}
+}
+
+func TestSummarizeRun(t *testing.T) {
+	state := GameState{
+		Round:        6,
+		NextQuestion: 4, // One of them skipped
+		AnswerHistory: []AnsweredQuestion{
+			{PlayerID: "P1", QuestionID: 3, Correct: true},
+			{PlayerID: "P1", QuestionID: 0, Correct: false},
+			{PlayerID: "P1", QuestionID: 7, Correct: true},
+		},
+		Players: map[PlayerID]*PlayerState{
+			"P1": {ID: "P1", HP: 3, Kills: map[EnemyType]int{InfiniteLoop: 2, StackOverflow: 1}},
+		},
+	}
+
+	got := SummarizeRun(&state, true)
+	want := RunSummary{Win: true, Rounds: 5, HP: 3, Kills: 3, QuestionsAnswered: 3}
+	if got != want {
+		t.Errorf("SummarizeRun = %+v, want %+v", got, want)
+	}
+}
+
+func TestBetterRun(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b RunSummary
+	}{
+		{"win beats loss", RunSummary{Win: true, Rounds: 15}, RunSummary{Win: false, Rounds: 3, HP: 6}},
+		{"faster win", RunSummary{Win: true, Rounds: 8}, RunSummary{Win: true, Rounds: 9, HP: 6}},
+		{"longer survival", RunSummary{Rounds: 12}, RunSummary{Rounds: 10, HP: 4}},
+		{"more HP", RunSummary{Win: true, Rounds: 8, HP: 4}, RunSummary{Win: true, Rounds: 8, HP: 2, Kills: 9}},
+		{"more kills", RunSummary{Rounds: 8, Kills: 5}, RunSummary{Rounds: 8, Kills: 4, QuestionsAnswered: 20}},
+		{"more questions", RunSummary{Rounds: 8, QuestionsAnswered: 7}, RunSummary{Rounds: 8, QuestionsAnswered: 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !BetterRun(tt.a, tt.b) {
+				t.Errorf("expected %+v to rank above %+v", tt.a, tt.b)
+			}
+			if BetterRun(tt.b, tt.a) {
+				t.Errorf("expected %+v not to rank above %+v", tt.b, tt.a)
+			}
+		})
+	}
+}
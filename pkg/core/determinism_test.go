@@ -0,0 +1,109 @@
+package core
+
+import (
+	"bytes"
+	"testing"
+)
+
+// withGameData loads the shipped card, objective and question data for the duration of a test
+func withGameData(t *testing.T) {
+	t.Helper()
+	previousCards, previousObjectives := CardDB, ObjectiveDB
+	t.Cleanup(func() { CardDB, ObjectiveDB = previousCards, previousObjectives })
+	withTestQuestions(t)
+
+	if err := LoadCards("../../data"); err != nil {
+		t.Fatalf("failed to load cards: %v", err)
+	}
+	if err := LoadObjectives("../../data"); err != nil {
+		t.Fatalf("failed to load objectives: %v", err)
+	}
+}
+
+// playScriptedGame runs a fixed policy through every seeded system for a few
+// rounds and returns the serialized final state.
+func playScriptedGame(t *testing.T, seed int64, rounds int) []byte {
+	t.Helper()
+	log := NewEffectLog()
+	state := Apply(GameState{}, InitializeGameAction{Seed: seed, PlayerClasses: []DevClass{Frontend, Backend, DevOps}}, log)
+
+	for round := 0; round < rounds; round++ {
+		DrawPhase(&state)
+		for turn := 0; ; turn++ {
+			player := GetActivePlayer(&state)
+
+			// Step into the first neighbouring room, alternating right and wrong answers
+			if adjacent := GetAdjacentRooms(player.Location); len(adjacent) > 0 {
+				state.ActionsLeft--
+				state = Apply(state, MoveAction{PlayerID: player.ID, To: adjacent[0]}, log)
+				if question, pending := CurrentQuestion(&state); pending {
+					state = Apply(state, scriptedAnswer(question, player.ID, turn%2 == 0), log)
+				}
+			}
+
+			for _, action := range []Action{
+				SearchAction{PlayerID: player.ID},
+				ShootAction{PlayerID: player.ID},
+				MeleeAction{PlayerID: player.ID},
+				GiveSpecialCardAction{PlayerID: player.ID},
+			} {
+				state = Apply(state, action, log)
+			}
+			if hand := state.Players[player.ID].Hand; len(hand) > 0 {
+				state = Apply(state, PlayCardAction{PlayerID: player.ID, CardID: hand[0]}, log)
+			}
+			state = Apply(state, PassAction{PlayerID: player.ID}, log)
+
+			if !AdvanceTurn(&state) {
+				break
+			}
+		}
+		EventPhase(&state, log)
+		EndRoundMaintenance(&state)
+	}
+
+	data, err := SaveGameState(&state)
+	if err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+	return data
+}
+
+func TestSameSeedProducesIdenticalGames(t *testing.T) {
+	withGameData(t)
+
+	const runs = 30
+	want := playScriptedGame(t, 20240601, 4)
+	for i := 1; i < runs; i++ {
+		if got := playScriptedGame(t, 20240601, 4); !bytes.Equal(got, want) {
+			t.Fatalf("run %d produced a different save for the same seed", i)
+		}
+	}
+}
+
+func TestSameSeedProducesIdenticalSetup(t *testing.T) {
+	withGameData(t)
+
+	want, err := SaveGameState(ptr(initializeGameState(7, Frontend, Backend)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 50; i++ {
+		got, _ := SaveGameState(ptr(initializeGameState(7, Frontend, Backend)))
+		if !bytes.Equal(got, want) {
+			t.Fatalf("setup run %d differed for the same seed", i)
+		}
+	}
+}
+
+func TestDifferentSeedsProduceDifferentGames(t *testing.T) {
+	withGameData(t)
+
+	if bytes.Equal(playScriptedGame(t, 1, 2), playScriptedGame(t, 2, 2)) {
+		t.Error("expected different seeds to produce different games")
+	}
+}
+
+func ptr(state GameState) *GameState {
+	return &state
+}
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"testing"
+)
+
+func countSpawnTokens(bag *SpawnBag) map[EnemyType]int {
+	counts := make(map[EnemyType]int)
+	for _, token := range bag.Tokens {
+		counts[token]++
+	}
+	return counts
+}
+
+func TestInitializeGameActionAppliesDifficulty(t *testing.T) {
+	for _, difficulty := range []Difficulty{Easy, Normal, Hard} {
+		state := Apply(GameState{}, InitializeGameAction{Seed: 42, Difficulty: difficulty}, NewEffectLog())
+		if state.Difficulty != difficulty {
+			t.Errorf("expected difficulty %s, got %s", difficulty, state.Difficulty)
+		}
+
+		counts := countSpawnTokens(state.SpawnBag)
+		for _, enemyType := range EnemyTypes() {
+			if want := EnemyDB[enemyType].SpawnBag[difficulty]; counts[enemyType] != want {
+				t.Errorf("%s: expected %d tokens of type %d, got %d", difficulty, want, enemyType, counts[enemyType])
+			}
+		}
+	}
+}
+
+func TestInitializeGameActionDefaultsToNormal(t *testing.T) {
+	state := Apply(GameState{}, InitializeGameAction{Seed: 42}, NewEffectLog())
+	if state.Difficulty != Normal {
+		t.Errorf("expected normal difficulty by default, got %q", state.Difficulty)
+	}
+	if len(state.SpawnBag.Tokens) != 18 {
+		t.Errorf("expected the normal 18-token bag, got %d tokens", len(state.SpawnBag.Tokens))
+	}
+}
+
+func TestHardDifficultyHasStrongerBag(t *testing.T) {
+	easy := countSpawnTokens(initializeSpawnBag(Easy))
+	hard := countSpawnTokens(initializeSpawnBag(Hard))
+	if hard[Pythogoras] <= easy[Pythogoras] || hard[StackOverflow] <= easy[StackOverflow] {
+		t.Errorf("expected hard bag to hold more strong enemies: easy %v, hard %v", easy, hard)
+	}
+}
+
+func TestParseDifficulty(t *testing.T) {
+	if difficulty, err := ParseDifficulty("HARD"); err != nil || difficulty != Hard {
+		t.Errorf("expected Hard, got %q (%v)", difficulty, err)
+	}
+	if _, err := ParseDifficulty("nightmare"); err == nil {
+		t.Error("expected error for unknown difficulty")
+	}
+}
+
+func TestParseDevClass(t *testing.T) {
+	tests := map[string]DevClass{
+		"frontend":  Frontend,
+		"Backend":   Backend,
+		"DEVOPS":    DevOps,
+		"fullstack": Fullstack,
+	}
+	for name, want := range tests {
+		if class, err := ParseDevClass(name); err != nil || class != want {
+			t.Errorf("ParseDevClass(%q) = %v, %v; want %v", name, class, err, want)
+		}
+	}
+	if _, err := ParseDevClass("wizard"); err == nil {
+		t.Error("expected error for unknown class")
+	}
+}
@@ -0,0 +1,139 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Edge names the passage between two neighbouring rooms, e.g. "R11|R12".
+// The lower room ID comes first so both directions name the same edge.
+type Edge string
+
+// EdgeBetween returns the edge joining two rooms
+func EdgeBetween(a, b RoomID) Edge {
+	if b < a {
+		a, b = b, a
+	}
+	return Edge(string(a) + "|" + string(b))
+}
+
+// Rooms returns the two rooms an edge joins
+func (e Edge) Rooms() (RoomID, RoomID) {
+	a, b, _ := strings.Cut(string(e), "|")
+	return RoomID(a), RoomID(b)
+}
+
+// String renders an edge for players, e.g. "R11–R12"
+func (e Edge) String() string {
+	a, b := e.Rooms()
+	return fmt.Sprintf("%s–%s", a, b)
+}
+
+// DoorState is the door on a passage. Closed and locked doors stop
+// developers and enemies alike: developers open them (see OpenDoorAction),
+// enemies break them down.
+type DoorState string
+
+const (
+	DoorOpen      DoorState = ""          // Every passage starts open unless its map says otherwise
+	DoorClosed    DoorState = "closed"    // An enemy must stop and break it down
+	DoorLocked    DoorState = "locked"    // An enemy must smash the lock, then break the door down
+	DoorDestroyed DoorState = "destroyed" // Broken for good: open and can never be shut again
+)
+
+// shut reports whether a door stops developers and enemies
+func (d DoorState) shut() bool {
+	return d == DoorClosed || d == DoorLocked
+}
+
+// DoorBetween returns the state of the door between two neighbouring rooms
+func DoorBetween(state *GameState, a, b RoomID) DoorState {
+	return state.Doors[EdgeBetween(a, b)]
+}
+
+// setDoor changes a door; open doors are not stored so boards without
+// doors keep an empty map
+func setDoor(state *GameState, edge Edge, door DoorState) {
+	if door == DoorOpen {
+		delete(state.Doors, edge)
+		return
+	}
+	if state.Doors == nil {
+		state.Doors = make(map[Edge]DoorState)
+	}
+	state.Doors[edge] = door
+}
+
+// ShutDoors returns the closed and locked doors in edge order
+func ShutDoors(state *GameState) []Edge {
+	var edges []Edge
+	for _, edge := range mapEdges() {
+		if state.Doors[edge].shut() {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+// roomEdges returns the passages leading out of a room
+func roomEdges(roomID RoomID) []Edge {
+	neighbors := GetAdjacentRooms(roomID)
+	edges := make([]Edge, len(neighbors))
+	for i, neighbor := range neighbors {
+		edges[i] = EdgeBetween(roomID, neighbor)
+	}
+	return edges
+}
+
+// mapEdges returns every passage on the current map, ordered by room ID
+func mapEdges() []Edge {
+	if CurrentMap == nil {
+		return nil
+	}
+	var edges []Edge
+	for _, id := range CurrentMap.sortedRoomIDs() {
+		for _, neighbor := range GetAdjacentRooms(id) {
+			if id < neighbor {
+				edges = append(edges, EdgeBetween(id, neighbor))
+			}
+		}
+	}
+	return edges
+}
+
+// enemyBreaksDoor lets an enemy that reached a shut door damage it: a lock
+// is smashed first, leaving a closed door, and a closed door is broken down
+func enemyBreaksDoor(state *GameState, enemy *Enemy, edge Edge, log *EffectLog) {
+	switch state.Doors[edge] {
+	case DoorLocked:
+		setDoor(state, edge, DoorClosed)
+		log.Add("🔨 %s smashes the lock of door %s", getEnemyDisplayName(enemy.Type), edge)
+	case DoorClosed:
+		setDoor(state, edge, DoorDestroyed)
+		log.Add("💥 %s breaks down door %s", getEnemyDisplayName(enemy.Type), edge)
+	}
+}
+
+// playerOpensDoor opens the shut door between a player's room and a
+// neighbouring one: a lock is undone first, leaving a closed door
+func playerOpensDoor(state *GameState, player *PlayerState, to RoomID, log *EffectLog) {
+	edge := EdgeBetween(player.Location, to)
+	switch state.Doors[edge] {
+	case DoorLocked:
+		setDoor(state, edge, DoorClosed)
+		log.Add("🔓 %s unlocks door %s", player.ID, edge)
+	case DoorClosed:
+		setDoor(state, edge, DoorOpen)
+		log.Add("🚪 %s opens door %s", player.ID, edge)
+	}
+}
+
+// stringToDoorState converts a map file's door state
+func stringToDoorState(s string) (DoorState, error) {
+	switch door := DoorState(s); door {
+	case DoorClosed, DoorLocked, DoorDestroyed:
+		return door, nil
+	default:
+		return "", fmt.Errorf("unknown door state: %s (closed, locked or destroyed)", s)
+	}
+}
@@ -0,0 +1,223 @@
+package core
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// doorMap is a 2×3 ship with a wall between C and F:
+//
+//	A B C
+//	D E F
+const doorMap = `
+grid: {rows: 2, cols: 3}
+rooms:
+  - {id: A, row: 0, col: 0}
+  - {id: B, row: 0, col: 1}
+  - {id: C, row: 0, col: 2}
+  - {id: D, row: 1, col: 0}
+  - {id: E, row: 1, col: 1}
+  - {id: F, row: 1, col: 2}
+roles:
+  start: A
+  key: D
+  engines: [C]
+  escapes: [F]
+pool:
+  - {type: MedBay, count: 2}
+walls:
+  - [C, F]
+doors:
+  - {between: [B, A], state: closed}
+  - {between: [B, C], state: locked}
+`
+
+// newDoorGame starts a game on doorMap with P1 in playerRoom and one
+// Infinite Loop in enemyRoom
+func newDoorGame(t *testing.T, playerRoom, enemyRoom RoomID) GameState {
+	t.Helper()
+	withTestMap(t, doorMap)
+	state := Apply(GameState{}, InitializeGameAction{Seed: 1, PlayerClasses: []DevClass{Backend}}, NewEffectLog())
+	state.ActivePlayer = "P1"
+	state.Players["P1"].Location = playerRoom
+	state.Enemies["E1"] = &Enemy{ID: "E1", Type: InfiniteLoop, HP: 1, MaxHP: 1, Damage: 1, Location: enemyRoom}
+	return state
+}
+
+func TestMapWallsAndDoors(t *testing.T) {
+	state := newDoorGame(t, "A", "F")
+
+	if got := GetAdjacentRooms("C"); !reflect.DeepEqual(got, []RoomID{"B"}) {
+		t.Errorf("expected the wall to leave C next to B only, got %v", got)
+	}
+	want := map[Edge]DoorState{"A|B": DoorClosed, "B|C": DoorLocked}
+	if !reflect.DeepEqual(state.Doors, want) {
+		t.Errorf("expected the map's doors %v, got %v", want, state.Doors)
+	}
+	if got := ShutDoors(&state); !reflect.DeepEqual(got, []Edge{"A|B", "B|C"}) {
+		t.Errorf("expected shut doors A|B and B|C, got %v", got)
+	}
+
+	// Shut doors stop developers too
+	if CanMove(&state, "A", "B") || CanMove(&state, "B", "C") || !CanMove(&state, "A", "D") {
+		t.Error("expected developers to pass open doors only")
+	}
+
+	copied := deepCopyGameState(state)
+	copied.Doors["A|B"] = DoorDestroyed
+	if state.Doors["A|B"] != DoorClosed {
+		t.Error("expected the deep copy to own its doors")
+	}
+}
+
+func TestEnemiesBreakShutDoors(t *testing.T) {
+	move := Effect{Op: MoveEnemies, Scope: AllRooms, N: 1}
+
+	// A closed door costs the enemy its move and is destroyed
+	state := newDoorGame(t, "A", "B")
+	ApplyMoveEnemies(&state, move, "", NewEffectLog())
+	if loc := state.Enemies["E1"].Location; loc != "B" || state.Doors["A|B"] != DoorDestroyed {
+		t.Fatalf("expected E1 to stay in B and break the door, got %s with %v", loc, state.Doors)
+	}
+	ApplyMoveEnemies(&state, move, "", NewEffectLog())
+	if loc := state.Enemies["E1"].Location; loc != "A" {
+		t.Fatalf("expected E1 through the destroyed door, got %s", loc)
+	}
+
+	// A locked door takes two moves to get through
+	state = newDoorGame(t, "B", "C")
+	for i, door := range []DoorState{DoorClosed, DoorDestroyed} {
+		ApplyMoveEnemies(&state, move, "", NewEffectLog())
+		if state.Enemies["E1"].Location != "C" || state.Doors["B|C"] != door {
+			t.Fatalf("move %d: expected E1 in C and the door %q, got %s and %q", i+1, door, state.Enemies["E1"].Location, state.Doors["B|C"])
+		}
+	}
+	ApplyMoveEnemies(&state, move, "", NewEffectLog())
+	if loc := state.Enemies["E1"].Location; loc != "B" {
+		t.Fatalf("expected E1 through the broken door, got %s", loc)
+	}
+
+	// An equally short way through open doors is taken instead
+	state = newDoorGame(t, "E", "A")
+	ApplyMoveEnemies(&state, move, "", NewEffectLog())
+	if loc := state.Enemies["E1"].Location; loc != "D" || state.Doors["A|B"] != DoorClosed {
+		t.Fatalf("expected E1 to go around the closed door through D, got %s with %v", loc, state.Doors)
+	}
+}
+
+func TestDevelopersOpenShutDoors(t *testing.T) {
+	state := newDoorGame(t, "B", "F")
+	state.ActionsLeft = 3
+	for _, room := range state.Rooms {
+		room.Explored, room.Corrupted = true, false
+	}
+
+	// Moves through shut doors are disabled, and opening them is offered
+	legal := make(map[Action]error)
+	for _, option := range LegalActions(&state) {
+		legal[option.Action] = option.Err
+	}
+	for _, to := range []RoomID{"A", "C"} {
+		if err := legal[MoveAction{PlayerID: "P1", To: to}]; !errors.Is(err, ErrDoorShut) {
+			t.Errorf("expected the move to %s disabled by its shut door, got %v", to, err)
+		}
+		if err, listed := legal[OpenDoorAction{PlayerID: "P1", To: to}]; !listed || err != nil {
+			t.Errorf("expected opening the door to %s offered, got %v", to, err)
+		}
+	}
+	if err := legal[MoveAction{PlayerID: "P1", To: "E"}]; err != nil {
+		t.Errorf("expected the move through the open door to E legal, got %v", err)
+	}
+	if err := ValidateAction(&state, OpenDoorAction{PlayerID: "P1", To: "E"}); !errors.Is(err, ErrDoorOpen) {
+		t.Errorf("expected the open door to E rejected, got %v", err)
+	}
+	if path := PlanSprint(&state, "P1", "C", 3); path.Valid {
+		t.Errorf("expected no sprint through the locked door to C, got %v", path.Path)
+	}
+
+	// A locked door takes two actions to open, then the move goes through
+	log := NewEffectLog()
+	for i, door := range []DoorState{DoorClosed, DoorOpen} {
+		state = Apply(state, OpenDoorAction{PlayerID: "P1", To: "C"}, log)
+		if state.Doors["B|C"] != door {
+			t.Fatalf("open %d: expected the door %q, got %q", i+1, door, state.Doors["B|C"])
+		}
+	}
+	if cost := ActionCost(&state, OpenDoorAction{PlayerID: "P1", To: "A"}); cost != 1 {
+		t.Errorf("expected opening a door to cost 1 action, got %d", cost)
+	}
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "C"}, log)
+	if loc := state.Players["P1"].Location; loc != "C" {
+		t.Errorf("expected P1 through the opened door into C, got %s", loc)
+	}
+	if lines := strings.Join(log.Lines, "\n"); !strings.Contains(lines, "🔓 P1 unlocks door B–C") || !strings.Contains(lines, "🚪 P1 opens door B–C") {
+		t.Errorf("expected the unlock and the opening logged, got %v", log.Lines)
+	}
+}
+
+func TestDoorEffects(t *testing.T) {
+	state := newDoorGame(t, "B", "F")
+	log := NewEffectLog()
+
+	ApplyCloseDoors(&state, Effect{Op: CloseDoors, Scope: CurrentRoom, N: 1}, "P1", log)
+	want := map[Edge]DoorState{"A|B": DoorClosed, "B|C": DoorLocked, "B|E": DoorClosed}
+	if !reflect.DeepEqual(state.Doors, want) {
+		t.Fatalf("expected B's open door closed, got %v", state.Doors)
+	}
+
+	ApplyLockDoors(&state, Effect{Op: LockDoors, Scope: CurrentRoom, N: 1}, "P1", log)
+	want = map[Edge]DoorState{"A|B": DoorLocked, "B|C": DoorLocked, "B|E": DoorLocked}
+	if !reflect.DeepEqual(state.Doors, want) {
+		t.Fatalf("expected all of B's doors locked, got %v", state.Doors)
+	}
+
+	ApplyBreakDoors(&state, Effect{Op: BreakDoors, Scope: AllRooms, N: 2}, "", log)
+	destroyed := 0
+	for _, door := range state.Doors {
+		if door == DoorDestroyed {
+			destroyed++
+		}
+	}
+	if destroyed != 2 || len(ShutDoors(&state)) != 1 {
+		t.Fatalf("expected two of the locked doors destroyed, got %v", state.Doors)
+	}
+
+	// Destroyed doors can never be shut again
+	ApplyCloseDoors(&state, Effect{Op: CloseDoors, Scope: AllRooms, N: 1}, "", log)
+	for edge, door := range state.Doors {
+		if door == DoorOpen {
+			t.Errorf("expected door %s to be stored only when not open", edge)
+		}
+	}
+	if len(state.Doors) != 6 || len(ShutDoors(&state)) != 4 {
+		t.Errorf("expected every open door closed and the destroyed ones left alone, got %v", state.Doors)
+	}
+	if !strings.Contains(strings.Join(log.Lines, "\n"), "🔒 Door A–B locked") {
+		t.Errorf("expected the lock to be logged, got %v", log.Lines)
+	}
+}
+
+func TestLoadMapDoorValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		replace [2]string
+		want    string
+	}{
+		{"not neighbours", [2]string{"[C, F]", "[A, C]"}, "rooms are not neighbours"},
+		{"unknown room", [2]string{"[C, F]", "[C, Z]"}, "room is not on the map"},
+		{"wall and door", [2]string{"[C, F]", "[A, B]"}, "passage already has a wall or door"},
+		{"bad state", [2]string{"state: closed", "state: ajar"}, "unknown door state: ajar"},
+		{"walled off", [2]string{"  - [C, F]", "  - [C, F]\n  - [E, F]"}, "not connected to the start room: [F]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeMapFile(t, dir, "bad", strings.Replace(doorMap, tt.replace[0], tt.replace[1], 1))
+			if err := LoadMap(dir, "bad"); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected an error mentioning %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
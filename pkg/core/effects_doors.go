@@ -0,0 +1,83 @@
+package core
+
+// getDoorTargets resolves which doors are affected by door effects: the
+// passages out of the scope's rooms, each listed once
+func getDoorTargets(state *GameState, scope ScopeType, playerID PlayerID) []Edge {
+	if scope == AllRooms {
+		return mapEdges()
+	}
+
+	seen := make(map[Edge]bool)
+	var edges []Edge
+	for _, room := range getRoomTargets(state, scope, playerID) {
+		for _, edge := range roomEdges(room.ID) {
+			if !seen[edge] {
+				seen[edge] = true
+				edges = append(edges, edge)
+			}
+		}
+	}
+	return edges
+}
+
+// ApplyCloseDoors closes the open doors in scope; locked doors stay locked
+// and destroyed doors cannot be closed
+func ApplyCloseDoors(state *GameState, effect Effect, playerID PlayerID, log *EffectLog) error {
+	closed := 0
+	for _, edge := range getDoorTargets(state, effect.Scope, playerID) {
+		if state.Doors[edge] == DoorOpen {
+			setDoor(state, edge, DoorClosed)
+			log.Add("🚪 Door %s closed", edge)
+			closed++
+		}
+	}
+	if closed == 0 {
+		log.Add("🚪 CloseDoors: No open doors to close")
+	}
+	return nil
+}
+
+// ApplyLockDoors locks the open and closed doors in scope
+func ApplyLockDoors(state *GameState, effect Effect, playerID PlayerID, log *EffectLog) error {
+	locked := 0
+	for _, edge := range getDoorTargets(state, effect.Scope, playerID) {
+		if door := state.Doors[edge]; door == DoorOpen || door == DoorClosed {
+			setDoor(state, edge, DoorLocked)
+			log.Add("🔒 Door %s locked", edge)
+			locked++
+		}
+	}
+	if locked == 0 {
+		log.Add("🔒 LockDoors: No doors left to lock")
+	}
+	return nil
+}
+
+// ApplyBreakDoors destroys N random doors in scope, shut ones first, so they
+// can never be closed against enemies again
+func ApplyBreakDoors(state *GameState, effect Effect, playerID PlayerID, log *EffectLog) error {
+	var shut, open []Edge
+	for _, edge := range getDoorTargets(state, effect.Scope, playerID) {
+		switch door := state.Doors[edge]; {
+		case door.shut():
+			shut = append(shut, edge)
+		case door == DoorOpen:
+			open = append(open, edge)
+		}
+	}
+
+	rng := GetGameRNG(state)
+	rng.Shuffle(len(shut), func(i, j int) { shut[i], shut[j] = shut[j], shut[i] })
+	rng.Shuffle(len(open), func(i, j int) { open[i], open[j] = open[j], open[i] })
+	candidates := append(shut, open...)
+	if len(candidates) == 0 {
+		log.Add("💥 BreakDoors: No doors left to break")
+		return nil
+	}
+
+	for i := 0; i < effect.N && i < len(candidates); i++ {
+		setDoor(state, candidates[i], DoorDestroyed)
+		log.Add("💥 Door %s destroyed", candidates[i])
+	}
+	return nil
+}
@@ -31,115 +31,162 @@ func ApplySpawnEnemy(state *GameState, effect Effect, playerID PlayerID, log *Ef
 	for _, room := range targets {
 		log.Add("🎯 Target room: %s", room.ID)
 		
-		// Convert N to enemy type
-		var enemyType EnemyType
-		switch effect.N {
-		case 1:
-			enemyType = InfiniteLoop
-		case 2:
-			enemyType = StackOverflow
-		case 3:
-			enemyType = Pythogoras
-		default:
-			return fmt.Errorf("invalid enemy type: %d", effect.N)
-		}
+		// N picks the enemy type: 1 is type 0 (Infinite Loop), 2 type 1 and so on
+		enemyType := EnemyType(effect.N - 1)
 		
 		// Create enemy directly (bypass spawn bag for special effects)
 		enemyID := EnemyID(fmt.Sprintf("E%d", len(state.Enemies)+1))
-		stats := ENEMY_STATS[enemyType]
-		enemy := &Enemy{
-			ID:       enemyID,
-			Type:     enemyType,
-			HP:       stats.HP,
-			MaxHP:    stats.HP,
-			Damage:   stats.Damage,
-			Location: room.ID,
+		enemy, exists := newEnemy(enemyID, enemyType, room.ID)
+		if !exists {
+			return fmt.Errorf("invalid enemy type: %d", effect.N)
 		}
 		state.Enemies[enemyID] = enemy
 		
 		log.Add("👹 %s spawned in %s", getEnemyDisplayName(enemyType), room.ID)
+		triggerAbilities(state, enemy, OnSpawn, log)
 	}
 	return nil
 }
 
-// ApplyMoveEnemies moves all enemies N steps toward players using the movement system
-func ApplyMoveEnemies(state *GameState, effect Effect, playerID PlayerID, log *EffectLog) error {
-	maxStep := effect.N
-	moved := 0
+// enemyPath finds an enemy's way to a room. Enemies that avoid corruption
+// go around corrupted rooms when they can and through them otherwise.
+func enemyPath(state *GameState, enemy *Enemy, to RoomID) PathResult {
+	query := PathQuery{
+		From:     enemy.Location,
+		To:       to,
+		MaxSteps: 99, // Effectively no cap - find any reachable target
+	}
+	if enemyDef(enemy.Type).AvoidsCorruption {
+		query.AvoidCorrupted = true
+		if path := CanTraverse(state, query); path.Valid {
+			return path
+		}
+		query.AvoidCorrupted = false
+	}
+	return CanTraverse(state, query)
+}
 
-	for _, enemy := range state.Enemies {
-		var bestPath PathResult
-		var bestLen int
-		var targetType string
-
-		// Pythogoras (boss) moves toward escape rooms instead of players
-		if enemy.Type == Pythogoras {
-			bestPath = PathResult{}
-			bestLen = 999
-			targetType = "escape room"
-			
-			// Find shortest path to either escape room (R19 or R20)
-			escapeRooms := []RoomID{"R19", "R20"}
-			for _, escapeRoom := range escapeRooms {
-				path := CanTraverse(state, PathQuery{
-					From:     enemy.Location,
-					To:       escapeRoom,
-					MaxSteps: 99,
-				})
-				if path.Valid {
-					if pathLen := len(path.Path) - 1; pathLen < bestLen {
-						bestLen, bestPath = pathLen, path
-					}
-				}
-			}
-		} else {
-			// Regular enemies move toward players
-			bestPath = PathResult{}
-			bestLen = 999
-			targetType = "player"
-			
-			for _, player := range state.Players {
-				if player.HP == 0 {
-					continue // Skip dead players
-				}
-				path := CanTraverse(state, PathQuery{
-					From:     enemy.Location,
-					To:       player.Location,
-					MaxSteps: 99, // Effectively no cap - find any reachable player
-				})
-				if !path.Valid {
-					continue // Player not reachable
-				}
-				if pathLen := len(path.Path) - 1; pathLen < bestLen {
-					bestLen, bestPath = pathLen, path
-				}
-			}
+// enemyTarget finds the shortest path from an enemy to what its type
+// targets (see EnemyDef.Target), with a note on the target for the log that
+// is empty when the enemy chases players
+func enemyTarget(state *GameState, enemy *Enemy, def EnemyDef) (PathResult, string) {
+	switch def.Target {
+	case TargetEscapeRoom:
+		return nearestEnemyPath(state, enemy, EscapeRooms()), "guarding escape room"
+	case TargetMostBugs:
+		if path := nearestEnemyPath(state, enemy, buggiestRooms(state)); path.Valid {
+			return path, "drawn to the bugs"
 		}
+	}
 
-		if !bestPath.Valid {
-			continue // No reachable target found
+	// Living players, nearest first and in seat order on ties
+	var rooms []RoomID
+	for _, targetID := range seatOrder(state) {
+		if player := state.Players[targetID]; player.HP > 0 {
+			rooms = append(rooms, player.Location)
 		}
+	}
+	return nearestEnemyPath(state, enemy, rooms), ""
+}
 
-		// 2️⃣ Move up to N steps along that path
-		step := maxStep
-		if bestLen < step {
-			step = bestLen // Can't move more steps than path length
+// nearestEnemyPath returns the enemy's shortest path to any of the rooms,
+// preferring earlier rooms on ties
+func nearestEnemyPath(state *GameState, enemy *Enemy, rooms []RoomID) PathResult {
+	var best PathResult
+	for _, room := range rooms {
+		if path := enemyPath(state, enemy, room); path.Valid && (!best.Valid || len(path.Path) < len(best.Path)) {
+			best = path
 		}
-		if step == 0 {
-			continue // Already at target location
+	}
+	return best
+}
+
+// buggiestRooms returns the rooms holding the most bugs in ID order, or
+// nothing while no room has bugs
+func buggiestRooms(state *GameState) []RoomID {
+	var rooms []RoomID
+	most := 1
+	for _, id := range sortedRoomIDs(state) {
+		switch bugs := int(state.Rooms[id].BugMarkers); {
+		case bugs > most:
+			rooms, most = []RoomID{id}, bugs
+		case bugs == most:
+			rooms = append(rooms, id)
 		}
+	}
+	return rooms
+}
 
-		oldLocation := enemy.Location
-		enemy.Location = bestPath.Path[step]
-		if enemy.Type == Pythogoras {
-			log.Add("🚶 %s moves %s → %s (guarding %s)", getEnemyDisplayName(enemy.Type), oldLocation, enemy.Location, targetType)
-		} else {
-			log.Add("🚶 %s moves %s → %s", getEnemyDisplayName(enemy.Type), oldLocation, enemy.Location)
+// ApplyMoveEnemies moves all enemies N steps toward their targets using the
+// movement system. Each step moves an enemy as many rooms as its type's speed.
+func ApplyMoveEnemies(state *GameState, effect Effect, playerID PlayerID, log *EffectLog) error {
+	moved := 0
+
+	for _, enemyID := range sortedEnemyIDs(state) {
+		enemy := state.Enemies[enemyID]
+		if advanced, _ := advanceEnemy(state, enemy, effect.N*enemyDef(enemy.Type).Speed, log); advanced {
+			moved++
 		}
-		moved++
 	}
 	
 	log.Add("🚶 %d enemies moved", moved)
 	return nil
 }
 
+// advanceEnemy moves an enemy up to maxStep rooms toward its target. It
+// reports whether the enemy moved, and whether it broke at a shut door on the
+// way; an enemy that did neither stayed put at or cut off from its target.
+func advanceEnemy(state *GameState, enemy *Enemy, maxStep int, log *EffectLog) (moved, brokeDoor bool) {
+	def := enemyDef(enemy.Type)
+	bestPath, targetNote := enemyTarget(state, enemy, def)
+
+	if !bestPath.Valid {
+		return false, false // No reachable target found
+	}
+	bestLen := len(bestPath.Path) - 1
+
+	// Move up to maxStep rooms along that path
+	step := maxStep
+	if bestLen < step {
+		step = bestLen // Can't move more steps than path length
+	}
+	if step == 0 {
+		return false, false // Already at target location
+	}
+
+	// Take an equally short way around shut doors if there is one;
+	// otherwise stop at the first shut door and break it instead
+	target := bestPath.Path[len(bestPath.Path)-1]
+	detour := CanTraverse(state, PathQuery{
+		From:           enemy.Location,
+		To:             target,
+		MaxSteps:       bestLen,
+		OpenOnly:       true,
+		AvoidCorrupted: def.AvoidsCorruption,
+	})
+	if detour.Valid {
+		bestPath = detour
+	}
+	for i := 0; i < step; i++ {
+		edge := EdgeBetween(bestPath.Path[i], bestPath.Path[i+1])
+		if state.Doors[edge].shut() {
+			enemyBreaksDoor(state, enemy, edge, log)
+			brokeDoor = true
+			step = i
+			break
+		}
+	}
+	if step == 0 {
+		return false, brokeDoor // Spent the move on a door
+	}
+
+	oldLocation := enemy.Location
+	enemy.Location = bestPath.Path[step]
+	if targetNote != "" {
+		log.Add("🚶 %s moves %s → %s (%s)", getEnemyDisplayName(enemy.Type), oldLocation, enemy.Location, targetNote)
+	} else {
+		log.Add("🚶 %s moves %s → %s", getEnemyDisplayName(enemy.Type), oldLocation, enemy.Location)
+	}
+	return true, brokeDoor
+}
+
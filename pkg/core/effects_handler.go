@@ -28,6 +28,16 @@ func ApplyEffect(state *GameState, effect Effect, playerID PlayerID, log *Effect
 		err = ApplySpawnEnemy(state, effect, playerID, log)
 	case MoveEnemies:
 		err = ApplyMoveEnemies(state, effect, playerID, log)
+	case SkipQuestion:
+		err = ApplySkipQuestion(state, effect, playerID, log)
+	case CloseDoors:
+		err = ApplyCloseDoors(state, effect, playerID, log)
+	case LockDoors:
+		err = ApplyLockDoors(state, effect, playerID, log)
+	case BreakDoors:
+		err = ApplyBreakDoors(state, effect, playerID, log)
+	case MoveSelf:
+		err = ApplyMoveSelf(state, effect, playerID, log)
 	default:
 		err = fmt.Errorf("unknown effect op: %v", effect.Op)
 	}
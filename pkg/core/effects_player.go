@@ -101,15 +101,16 @@ func ApplyDiscardCards(state *GameState, effect Effect, playerID PlayerID, log *
 	return nil
 }
 
-// ApplySkipQuestion allows bypassing movement questions
+// ApplySkipQuestion grants skips the player can spend on movement questions
+// with SkipQuestionAction
 func ApplySkipQuestion(state *GameState, effect Effect, playerID PlayerID, log *EffectLog) error {
 	if effect.Scope != Self {
 		return fmt.Errorf("SkipQuestion only valid with Self scope")
 	}
 	player := state.Players[playerID]
 	if player != nil {
-		// TODO: Add skip counter to player struct
-		// player.QuestionSkips += effect.N
+		player.QuestionSkips += effect.N
+		log.Add("⏭️ %s can skip %d coding question(s)", player.ID, player.QuestionSkips)
 	}
 	return nil
 }
@@ -124,8 +125,8 @@ func getPlayerTargets(state *GameState, scope ScopeType, playerID PlayerID) []*P
 		return nil
 	case AllPlayers:
 		targets := make([]*PlayerState, 0, len(state.Players))
-		for _, player := range state.Players {
-			targets = append(targets, player)
+		for _, id := range seatOrder(state) {
+			targets = append(targets, state.Players[id])
 		}
 		return targets
 	default:
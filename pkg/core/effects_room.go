@@ -104,7 +104,8 @@ func ApplySetCorrupted(state *GameState, effect Effect, playerID PlayerID, log *
 	
 	// Find all rooms with < 3 bugs that can be corrupted
 	candidateRooms := make([]*RoomState, 0)
-	for _, room := range state.Rooms {
+	for _, id := range sortedRoomIDs(state) {
+		room := state.Rooms[id]
 		if room.BugMarkers < BugCorruptionThreshold && !room.OutOfRam {
 			candidateRooms = append(candidateRooms, room)
 		}
@@ -176,8 +177,8 @@ func getRoomTargets(state *GameState, scope ScopeType, playerID PlayerID) []*Roo
 		return targets
 	case AllRooms:
 		targets := make([]*RoomState, 0, len(state.Rooms))
-		for _, room := range state.Rooms {
-			targets = append(targets, room)
+		for _, id := range sortedRoomIDs(state) {
+			targets = append(targets, state.Rooms[id])
 		}
 		return targets
 	case RoomWithMostBugs:
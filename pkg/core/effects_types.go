@@ -18,6 +18,11 @@ const (
 	SetCorrupted
 	SpawnEnemy
 	MoveEnemies
+	SkipQuestion
+	CloseDoors
+	LockDoors
+	BreakDoors
+	MoveSelf
 )
 
 // ScopeType enumeration
@@ -55,5 +60,6 @@ type Card struct {
 	Name        string       `json:"name"`
 	Description string       `json:"description"`
 	Source      EffectSource `json:"source"`
+	Rarity      string       `json:"rarity,omitempty"` // "common", "uncommon" or "rare"; special cards only
 	Effects     []Effect     `json:"effects"`
 }
\ No newline at end of file
@@ -48,6 +48,11 @@ func isValidOpScope(op EffectOp, scope ScopeType) bool {
 		SetCorrupted: {CurrentRoom, AdjacentRooms, AllRooms, RoomWithMostBugs},
 		SpawnEnemy:   {CurrentRoom, RoomWithMostBugs},
 		MoveEnemies:  {AllRooms}, // Enemy movement affects all enemies
+		SkipQuestion: {Self},
+		CloseDoors:   {CurrentRoom, AllRooms},
+		LockDoors:    {CurrentRoom},
+		BreakDoors:   {CurrentRoom, AllRooms},
+		MoveSelf:     {Self},
 	}
 
 	scopes, exists := validScopes[op]
@@ -73,7 +78,7 @@ func isValidNValue(op EffectOp, n int) bool {
 	case DrawCards, DiscardCards:
 		return n >= 1 && n <= 5
 	case SpawnEnemy:
-		return n >= 1 && n <= 3
+		return n >= 1 // Enemy type n-1; checked against EnemyDB on spawn
 	case OutOfRam:
 		return n == 1
 	case RevealRoom, CleanRoom:
@@ -82,6 +87,14 @@ func isValidNValue(op EffectOp, n int) bool {
 		return n == 0 || n == 1
 	case MoveEnemies:
 		return n >= 1 && n <= 3 // 1-3 steps movement
+	case SkipQuestion:
+		return n >= 1 && n <= 3
+	case CloseDoors, LockDoors:
+		return n == 1
+	case BreakDoors:
+		return n >= 1 && n <= 3 // Doors broken
+	case MoveSelf:
+		return n >= 1 && n <= 3 // Rooms moved
 	default:
 		return false
 	}
@@ -93,7 +106,7 @@ func isValidPhaseOp(source EffectSource, op EffectOp) bool {
 	case SrcAction:
 		return true // All ops allowed in action phase
 	case SrcEvent:
-		allowedOps := []EffectOp{SpawnEnemy, ModifyBugs, SetCorrupted, CleanRoom, RevealRoom, MoveEnemies}
+		allowedOps := []EffectOp{SpawnEnemy, ModifyBugs, SetCorrupted, CleanRoom, RevealRoom, MoveEnemies, CloseDoors, BreakDoors}
 		for _, allowedOp := range allowedOps {
 			if op == allowedOp {
 				return true
@@ -0,0 +1,366 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnemyTarget is what an enemy type moves toward when enemies move
+type EnemyTarget string
+
+const (
+	TargetNearestPlayer EnemyTarget = "nearest_player"
+	TargetEscapeRoom    EnemyTarget = "escape_room"
+	TargetMostBugs      EnemyTarget = "most_bugs" // Nearest player while no room has bugs
+)
+
+// MaxEnemySpeed caps how many rooms an enemy moves per step of movement
+const MaxEnemySpeed = 3
+
+// MaxAbilityN caps the N of an enemy ability
+const MaxAbilityN = 9
+
+// MaxNoise caps the noise an action makes, how far an enemy hears and how
+// much noise it takes to alert one
+const MaxNoise = 9
+
+// EnemyDef describes an enemy type: its stats and how it behaves
+type EnemyDef struct {
+	Type             EnemyType
+	Key              string // Name used by data files, e.g. "stack_overflow"
+	Name             string
+	Abbrev           string // Two-letter map label
+	IDPrefix         string // Prefix of the IDs of enemies drawn from the spawn bag
+	Description      string
+	HP               uint8
+	Damage           uint8
+	Speed            int // Rooms moved per step of enemy movement; 0 stays put
+	Target           EnemyTarget
+	AvoidsCorruption bool
+	BlocksEscape     bool               // No engine activation while one is in an escape room
+	Upgrade          *EnemyType         // Token put back in the spawn bag after a draw; nil = none
+	SpawnBag         map[Difficulty]int // Tokens in a new spawn bag
+	Abilities        []EnemyAbility
+	Hearing          int // Rooms away it hears noise from
+	Alertness        int // Noise it must hear to hunt; 0 = always hunting
+}
+
+// NoiseModel is how much noise each player action makes in the player's room
+// (for moves, the room entered)
+type NoiseModel struct {
+	Move   int
+	Search int
+	Melee  int
+	Shoot  int
+	Room   int // Room abilities
+}
+
+// defaultNoise is used when enemies.yaml has no noise block
+var defaultNoise = NoiseModel{Move: 1, Search: 1, Melee: 2, Shoot: 3, Room: 1}
+
+// EnemyDatabase represents the YAML structure
+type EnemyDatabase struct {
+	Noise   *YAMLNoise  `yaml:"noise"`
+	Enemies []YAMLEnemy `yaml:"enemies"`
+}
+
+// YAMLNoise represents the noise model as stored in YAML
+type YAMLNoise struct {
+	Move   int `yaml:"move"`
+	Search int `yaml:"search"`
+	Melee  int `yaml:"melee"`
+	Shoot  int `yaml:"shoot"`
+	Room   int `yaml:"room"`
+}
+
+// YAMLEnemy represents an enemy type as stored in YAML
+type YAMLEnemy struct {
+	Type             *int           `yaml:"type"`
+	Key              string         `yaml:"key"`
+	Name             string         `yaml:"name"`
+	Abbrev           string         `yaml:"abbrev"`
+	IDPrefix         string         `yaml:"id_prefix"`
+	Desc             string         `yaml:"desc"`
+	HP               int            `yaml:"hp"`
+	Damage           int            `yaml:"damage"`
+	Speed            *int           `yaml:"speed"`
+	Target           string         `yaml:"target"`
+	AvoidsCorruption bool           `yaml:"avoids_corruption"`
+	BlocksEscape     bool           `yaml:"blocks_escape"`
+	Upgrade          string         `yaml:"upgrade"`
+	SpawnBag         map[string]int `yaml:"spawn_bag"`
+	Abilities        []YAMLAbility  `yaml:"abilities"`
+	Hearing          *int           `yaml:"hearing"`
+	Alertness        *int           `yaml:"alertness"`
+}
+
+// YAMLAbility represents an enemy ability as stored in YAML
+type YAMLAbility struct {
+	On    string `yaml:"on"`
+	Do    string `yaml:"do"`
+	N     int    `yaml:"n"`
+	Enemy string `yaml:"enemy"` // Key of the type summoned
+}
+
+var EnemyDB map[EnemyType]EnemyDef
+
+// ActionNoise is the noise model loaded alongside EnemyDB
+var ActionNoise = defaultNoise
+
+// LoadEnemies loads the enemy definitions from YAML file
+func LoadEnemies(dataPath string) error {
+	enemyFilePath := filepath.Join(dataPath, "enemies.yaml")
+
+	data, err := ioutil.ReadFile(enemyFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read enemies file: %w", err)
+	}
+
+	var db EnemyDatabase
+	if err := yaml.Unmarshal(data, &db); err != nil {
+		return fmt.Errorf("failed to parse enemies YAML: %w", err)
+	}
+
+	enemies, err := convertYAMLToEnemies(db.Enemies)
+	if err != nil {
+		return err
+	}
+	noise, err := convertYAMLToNoise(db.Noise)
+	if err != nil {
+		return err
+	}
+	EnemyDB, ActionNoise = enemies, noise
+	return nil
+}
+
+// convertYAMLToNoise converts and validates the noise model
+func convertYAMLToNoise(yamlNoise *YAMLNoise) (NoiseModel, error) {
+	if yamlNoise == nil {
+		return defaultNoise, nil
+	}
+	noise := NoiseModel(*yamlNoise)
+	for name, n := range map[string]int{"move": noise.Move, "search": noise.Search, "melee": noise.Melee, "shoot": noise.Shoot, "room": noise.Room} {
+		if n < 0 || n > MaxNoise {
+			return NoiseModel{}, fmt.Errorf("noise %s must be between 0 and %d, got %d", name, MaxNoise, n)
+		}
+	}
+	return noise, nil
+}
+
+// convertYAMLToEnemies converts and validates every enemy type. Upgrades
+// refer to other types by key, so keys are collected first.
+func convertYAMLToEnemies(yamlEnemies []YAMLEnemy) (map[EnemyType]EnemyDef, error) {
+	byKey := make(map[string]EnemyType, len(yamlEnemies))
+	for i, yamlEnemy := range yamlEnemies {
+		if yamlEnemy.Key == "" {
+			return nil, fmt.Errorf("enemy #%d: missing key", i+1)
+		}
+		if yamlEnemy.Type == nil || *yamlEnemy.Type < 0 {
+			return nil, fmt.Errorf("enemy %s: missing or negative type", yamlEnemy.Key)
+		}
+		if _, duplicate := byKey[yamlEnemy.Key]; duplicate {
+			return nil, fmt.Errorf("duplicate enemy key: %s", yamlEnemy.Key)
+		}
+		byKey[yamlEnemy.Key] = EnemyType(*yamlEnemy.Type)
+	}
+
+	enemies := make(map[EnemyType]EnemyDef, len(yamlEnemies))
+	for _, yamlEnemy := range yamlEnemies {
+		def, err := convertYAMLToEnemy(yamlEnemy, byKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert enemy %s: %w", yamlEnemy.Key, err)
+		}
+		if other, duplicate := enemies[def.Type]; duplicate {
+			return nil, fmt.Errorf("enemies %s and %s share type %d", other.Key, def.Key, def.Type)
+		}
+		enemies[def.Type] = def
+	}
+	if _, exists := enemies[InfiniteLoop]; !exists {
+		return nil, fmt.Errorf("missing enemy type %d, which corrupted rooms spawn", InfiniteLoop)
+	}
+	return enemies, nil
+}
+
+// convertYAMLToEnemy converts YAML enemy format to EnemyDef
+func convertYAMLToEnemy(yamlEnemy YAMLEnemy, byKey map[string]EnemyType) (EnemyDef, error) {
+	def := EnemyDef{
+		Type:             EnemyType(*yamlEnemy.Type),
+		Key:              yamlEnemy.Key,
+		Name:             yamlEnemy.Name,
+		Abbrev:           yamlEnemy.Abbrev,
+		IDPrefix:         yamlEnemy.IDPrefix,
+		Description:      yamlEnemy.Desc,
+		Speed:            1,
+		Target:           TargetNearestPlayer,
+		Hearing:          2,
+		Alertness:        1,
+		AvoidsCorruption: yamlEnemy.AvoidsCorruption,
+		BlocksEscape:     yamlEnemy.BlocksEscape,
+		SpawnBag:         make(map[Difficulty]int, len(yamlEnemy.SpawnBag)),
+	}
+
+	switch {
+	case def.Name == "":
+		return EnemyDef{}, fmt.Errorf("missing name")
+	case utf8.RuneCountInString(def.Abbrev) != 2:
+		return EnemyDef{}, fmt.Errorf("abbrev must be 2 characters, got %q", def.Abbrev)
+	case def.IDPrefix == "":
+		return EnemyDef{}, fmt.Errorf("missing id_prefix")
+	case yamlEnemy.HP < 1 || yamlEnemy.HP > 255:
+		return EnemyDef{}, fmt.Errorf("hp must be between 1 and 255, got %d", yamlEnemy.HP)
+	case yamlEnemy.Damage < 0 || yamlEnemy.Damage > 255:
+		return EnemyDef{}, fmt.Errorf("damage must be between 0 and 255, got %d", yamlEnemy.Damage)
+	}
+	def.HP, def.Damage = uint8(yamlEnemy.HP), uint8(yamlEnemy.Damage)
+
+	if yamlEnemy.Speed != nil {
+		if *yamlEnemy.Speed < 0 || *yamlEnemy.Speed > MaxEnemySpeed {
+			return EnemyDef{}, fmt.Errorf("speed must be between 0 and %d, got %d", MaxEnemySpeed, *yamlEnemy.Speed)
+		}
+		def.Speed = *yamlEnemy.Speed
+	}
+	if yamlEnemy.Hearing != nil {
+		if *yamlEnemy.Hearing < 0 || *yamlEnemy.Hearing > MaxNoise {
+			return EnemyDef{}, fmt.Errorf("hearing must be between 0 and %d, got %d", MaxNoise, *yamlEnemy.Hearing)
+		}
+		def.Hearing = *yamlEnemy.Hearing
+	}
+	if yamlEnemy.Alertness != nil {
+		if *yamlEnemy.Alertness < 0 || *yamlEnemy.Alertness > MaxNoise {
+			return EnemyDef{}, fmt.Errorf("alertness must be between 0 and %d, got %d", MaxNoise, *yamlEnemy.Alertness)
+		}
+		def.Alertness = *yamlEnemy.Alertness
+	}
+
+	switch target := EnemyTarget(yamlEnemy.Target); target {
+	case "":
+	case TargetNearestPlayer, TargetEscapeRoom, TargetMostBugs:
+		def.Target = target
+	default:
+		return EnemyDef{}, fmt.Errorf("unknown target: %s", yamlEnemy.Target)
+	}
+
+	if yamlEnemy.Upgrade != "" {
+		upgrade, exists := byKey[yamlEnemy.Upgrade]
+		if !exists {
+			return EnemyDef{}, fmt.Errorf("unknown upgrade: %s", yamlEnemy.Upgrade)
+		}
+		def.Upgrade = &upgrade
+	}
+
+	for name, count := range yamlEnemy.SpawnBag {
+		difficulty, err := ParseDifficulty(name)
+		if err != nil {
+			return EnemyDef{}, fmt.Errorf("spawn_bag: %w", err)
+		}
+		if count < 0 {
+			return EnemyDef{}, fmt.Errorf("spawn_bag %s: count must not be negative, got %d", name, count)
+		}
+		def.SpawnBag[difficulty] = count
+	}
+
+	for i, yamlAbility := range yamlEnemy.Abilities {
+		ability, err := convertYAMLToAbility(yamlAbility, byKey)
+		if err != nil {
+			return EnemyDef{}, fmt.Errorf("ability #%d: %w", i+1, err)
+		}
+		def.Abilities = append(def.Abilities, ability)
+	}
+	return def, nil
+}
+
+// convertYAMLToAbility converts YAML ability format to EnemyAbility
+func convertYAMLToAbility(yamlAbility YAMLAbility, byKey map[string]EnemyType) (EnemyAbility, error) {
+	ability := EnemyAbility{
+		Hook: EnemyHook(yamlAbility.On),
+		Kind: AbilityKind(yamlAbility.Do),
+		N:    yamlAbility.N,
+	}
+
+	hooks, exists := abilityHooks[ability.Kind]
+	if !exists {
+		return EnemyAbility{}, fmt.Errorf("unknown ability: %s", yamlAbility.Do)
+	}
+	hookAllowed := false
+	for _, hook := range hooks {
+		hookAllowed = hookAllowed || hook == ability.Hook
+	}
+	if !hookAllowed {
+		return EnemyAbility{}, fmt.Errorf("%s cannot fire on %q", ability.Kind, yamlAbility.On)
+	}
+	if ability.N < 1 || ability.N > MaxAbilityN {
+		return EnemyAbility{}, fmt.Errorf("n must be between 1 and %d, got %d", MaxAbilityN, ability.N)
+	}
+
+	switch {
+	case ability.Kind == AbilitySummon:
+		summons, exists := byKey[yamlAbility.Enemy]
+		if !exists {
+			return EnemyAbility{}, fmt.Errorf("unknown enemy to summon: %q", yamlAbility.Enemy)
+		}
+		ability.Summons = summons
+	case yamlAbility.Enemy != "":
+		return EnemyAbility{}, fmt.Errorf("only summon takes an enemy")
+	}
+	return ability, nil
+}
+
+// EnemyTypes returns the defined enemy types in type order
+func EnemyTypes() []EnemyType {
+	types := make([]EnemyType, 0, len(EnemyDB))
+	for enemyType := range EnemyDB {
+		types = append(types, enemyType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// enemyDef returns an enemy type's definition. Types missing from EnemyDB
+// chase the nearest player one room at a time.
+func enemyDef(enemyType EnemyType) EnemyDef {
+	if def, exists := EnemyDB[enemyType]; exists {
+		return def
+	}
+	return EnemyDef{
+		Type:      enemyType,
+		Name:      "Enemy",
+		Abbrev:    "??",
+		IDPrefix:  "UNKNOWN",
+		Speed:     1,
+		Target:    TargetNearestPlayer,
+		Hearing:   2,
+		Alertness: 1,
+	}
+}
+
+// newEnemy creates an enemy of a defined type at full health, or returns
+// false for types missing from EnemyDB
+func newEnemy(id EnemyID, enemyType EnemyType, location RoomID) (*Enemy, bool) {
+	def, exists := EnemyDB[enemyType]
+	if !exists {
+		return nil, false
+	}
+	return &Enemy{
+		ID:       id,
+		Type:     enemyType,
+		HP:       def.HP,
+		MaxHP:    def.HP,
+		Damage:   def.Damage,
+		Location: location,
+	}, true
+}
+
+// stringToEnemyType converts a data-file enemy key to EnemyType
+func stringToEnemyType(s string) (EnemyType, error) {
+	for enemyType, def := range EnemyDB {
+		if def.Key == s {
+			return enemyType, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown enemy type: %s", s)
+}
@@ -0,0 +1,164 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// withTestEnemies installs enemy definitions and the noise model from YAML
+// for one test
+func withTestEnemies(t *testing.T, content string) {
+	t.Helper()
+	previous, previousNoise := EnemyDB, ActionNoise
+	t.Cleanup(func() { EnemyDB, ActionNoise = previous, previousNoise })
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "enemies.yaml"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadEnemies(dir); err != nil {
+		t.Fatalf("failed to load test enemies: %v", err)
+	}
+}
+
+// memoryLeakEnemies adds a fast, bug-hungry Memory Leak that Infinite Loops
+// upgrade into
+const memoryLeakEnemies = `
+enemies:
+  - {type: 0, key: infinite_loop, name: Infinite Loop, abbrev: IL, id_prefix: LOOP, hp: 1, damage: 1, upgrade: memory_leak}
+  - type: 3
+    key: memory_leak
+    name: Memory Leak
+    abbrev: ML
+    id_prefix: LEAK
+    hp: 2
+    damage: 2
+    speed: 2
+    target: most_bugs
+    spawn_bag: {normal: 1}
+`
+
+const testMemoryLeak EnemyType = 3
+
+func TestLoadEnemiesAddsNewType(t *testing.T) {
+	withTestEnemies(t, memoryLeakEnemies)
+
+	if name := getEnemyDisplayName(testMemoryLeak); name != "Memory Leak" {
+		t.Errorf("expected Memory Leak, got %s", name)
+	}
+	if bag := initializeSpawnBag(Normal); len(bag.Tokens) != 1 || bag.Tokens[0] != testMemoryLeak {
+		t.Errorf("expected one Memory Leak token in the normal bag, got %v", bag.Tokens)
+	}
+
+	bag := &SpawnBag{}
+	addStrongerToken(bag, InfiniteLoop, NewEffectLog())
+	addStrongerToken(bag, testMemoryLeak, NewEffectLog())
+	if len(bag.Tokens) != 1 || bag.Tokens[0] != testMemoryLeak {
+		t.Errorf("expected an Infinite Loop to upgrade to a Memory Leak and no further, got %v", bag.Tokens)
+	}
+
+	state := newTestGameState()
+	state.SpawnBag = &SpawnBag{Tokens: []EnemyType{testMemoryLeak}}
+	if !SpawnEnemyFromBag(&state, "R07", NewEffectLog()) {
+		t.Fatal("expected a Memory Leak to spawn")
+	}
+	for _, enemy := range state.Enemies {
+		if enemy.Type == testMemoryLeak && (enemy.HP != 2 || enemy.MaxHP != 2 || enemy.Damage != 2) {
+			t.Errorf("expected a 2 HP, 2 damage Memory Leak, got %+v", enemy)
+		}
+	}
+}
+
+func TestLoadEnemiesRejectsBadDefinitions(t *testing.T) {
+	loop := "{type: 0, key: infinite_loop, name: Infinite Loop, abbrev: IL, id_prefix: LOOP, hp: 1, damage: 1}"
+	tests := []struct {
+		name  string
+		entry string
+		want  string
+	}{
+		{"missing type 0", strings.Replace(loop, "type: 0", "type: 5", 1), "missing enemy type 0"},
+		{"long abbrev", strings.Replace(loop, "IL", "ILP", 1), "abbrev must be 2 characters"},
+		{"no hp", strings.Replace(loop, "hp: 1", "hp: 0", 1), "hp must be between 1 and 255"},
+		{"too fast", strings.Replace(loop, "}", ", speed: 4}", 1), "speed must be between 0 and 3"},
+		{"unknown target", strings.Replace(loop, "}", ", target: treasure}", 1), "unknown target"},
+		{"unknown upgrade", strings.Replace(loop, "}", ", upgrade: kraken}", 1), "unknown upgrade"},
+		{"unknown difficulty", strings.Replace(loop, "}", ", spawn_bag: {nightmare: 1}}", 1), "spawn_bag"},
+		{"unknown ability", strings.Replace(loop, "}", ", abilities: [{on: death, do: explode, n: 1}]}", 1), "unknown ability"},
+		{"wrong hook", strings.Replace(loop, "}", ", abilities: [{on: death, do: duplicate, n: 1}]}", 1), "duplicate cannot fire on"},
+		{"no n", strings.Replace(loop, "}", ", abilities: [{on: death, do: spread_bugs}]}", 1), "n must be between 1 and 9"},
+		{"unknown summon", strings.Replace(loop, "}", ", abilities: [{on: death, do: summon, n: 1, enemy: kraken}]}", 1), "unknown enemy to summon"},
+		{"deaf", strings.Replace(loop, "}", ", hearing: -1}", 1), "hearing must be between 0 and 9"},
+		{"too calm", strings.Replace(loop, "}", ", alertness: 10}", 1), "alertness must be between 0 and 9"},
+		{"shared type", loop + "\n  - " + strings.Replace(loop, "infinite_loop", "other_loop", 1), "share type 0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			content := "enemies:\n  - " + tt.entry + "\n"
+			if err := os.WriteFile(filepath.Join(dir, "enemies.yaml"), []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			err := LoadEnemies(dir)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected an error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestEnemyTargetAndSpeed(t *testing.T) {
+	withTestEnemies(t, memoryLeakEnemies)
+	withTestMap(t, corruptionMap)
+	move := Effect{Op: MoveEnemies, Scope: AllRooms, N: 1}
+
+	newState := func() GameState {
+		state := Apply(GameState{}, InitializeGameAction{Seed: 1, PlayerClasses: []DevClass{Backend}}, NewEffectLog())
+		for _, room := range state.Rooms {
+			room.BugMarkers = 0
+		}
+		state.Enemies = map[EnemyID]*Enemy{"E1": {ID: "E1", Type: testMemoryLeak, HP: 2, MaxHP: 2, Damage: 2, Location: "A"}}
+		return state
+	}
+
+	// With no bugs aboard it runs two rooms toward the player in C
+	state := newState()
+	ApplyMoveEnemies(&state, move, "", NewEffectLog())
+	if loc := state.Enemies["E1"].Location; loc != "C" {
+		t.Errorf("expected the Memory Leak to cover A → B → C, got %s", loc)
+	}
+
+	// Bugs draw it away from the player
+	state = newState()
+	state.Rooms["D"].BugMarkers = 2
+	log := NewEffectLog()
+	ApplyMoveEnemies(&state, move, "", log)
+	if loc := state.Enemies["E1"].Location; loc != "D" {
+		t.Errorf("expected the Memory Leak drawn to the bugs in D, got %s", loc)
+	}
+	if !strings.Contains(strings.Join(log.Lines, "\n"), "drawn to the bugs") {
+		t.Errorf("expected the target to be logged, got %v", log.Lines)
+	}
+}
+
+func TestLoadEnemiesNoiseModel(t *testing.T) {
+	loop := "enemies:\n  - {type: 0, key: infinite_loop, name: Infinite Loop, abbrev: IL, id_prefix: LOOP, hp: 1, damage: 1}\n"
+	withTestEnemies(t, loop)
+	if ActionNoise != defaultNoise {
+		t.Errorf("expected the default noise model without a noise block, got %+v", ActionNoise)
+	}
+
+	withTestEnemies(t, "noise: {move: 2, shoot: 5}\n"+loop)
+	if want := (NoiseModel{Move: 2, Shoot: 5}); ActionNoise != want {
+		t.Errorf("expected %+v, got %+v", want, ActionNoise)
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "enemies.yaml"), []byte("noise: {shoot: 10}\n"+loop), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadEnemies(dir); err == nil || !strings.Contains(err.Error(), "noise shoot must be between 0 and 9") {
+		t.Errorf("expected a noise range error, got %v", err)
+	}
+}
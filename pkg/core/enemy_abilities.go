@@ -0,0 +1,177 @@
+package core
+
+import "fmt"
+
+// EnemyHook is a moment in an enemy's life its abilities can fire on
+type EnemyHook string
+
+const (
+	OnSpawn    EnemyHook = "spawn"     // Entered play from the spawn bag, a card or corruption
+	OnRoundEnd EnemyHook = "round_end" // Survived an event phase
+	OnDamaged  EnemyHook = "damaged"   // Lost HP and lived
+	OnDeath    EnemyHook = "death"     // Lost its last HP
+)
+
+// AbilityKind is what an enemy ability does
+type AbilityKind string
+
+const (
+	AbilityDuplicate  AbilityKind = "duplicate"   // Copies itself every N round ends it lives through
+	AbilityRegenerate AbilityKind = "regenerate"  // Heals N HP, up to its max
+	AbilitySpreadBugs AbilityKind = "spread_bugs" // Adds N bugs to every room next to it
+	AbilitySummon     AbilityKind = "summon"      // Brings N enemies of another type into its room
+)
+
+// EnemyAbility is something an enemy type does when a hook fires
+type EnemyAbility struct {
+	Hook    EnemyHook
+	Kind    AbilityKind
+	N       int
+	Summons EnemyType // AbilitySummon only
+}
+
+// abilityHooks lists the hooks each ability can fire on
+var abilityHooks = map[AbilityKind][]EnemyHook{
+	AbilityDuplicate:  {OnRoundEnd},
+	AbilityRegenerate: {OnRoundEnd, OnDamaged},
+	AbilitySpreadBugs: {OnSpawn, OnRoundEnd, OnDamaged, OnDeath},
+	AbilitySummon:     {OnSpawn, OnRoundEnd, OnDamaged, OnDeath},
+}
+
+// Describe summarises the ability for the rules, e.g.
+// "on death: spreads 1 bug to every neighbouring room"
+func (a EnemyAbility) Describe() string {
+	var what string
+	switch a.Kind {
+	case AbilityDuplicate:
+		what = fmt.Sprintf("copies itself every %d rounds it survives", a.N)
+	case AbilityRegenerate:
+		what = fmt.Sprintf("regenerates %d HP", a.N)
+	case AbilitySpreadBugs:
+		what = fmt.Sprintf("spreads %d bug(s) to every neighbouring room", a.N)
+	case AbilitySummon:
+		what = fmt.Sprintf("summons %d %s into its room", a.N, getEnemyDisplayName(a.Summons))
+	default:
+		what = string(a.Kind)
+	}
+
+	switch a.Hook {
+	case OnRoundEnd:
+		return "end of round: " + what
+	case OnDamaged:
+		return "when hit: " + what
+	default:
+		return fmt.Sprintf("on %s: %s", a.Hook, what)
+	}
+}
+
+// triggerAbilities fires an enemy's abilities for a hook
+func triggerAbilities(state *GameState, enemy *Enemy, hook EnemyHook, log *EffectLog) {
+	for _, ability := range enemyDef(enemy.Type).Abilities {
+		if ability.Hook == hook {
+			useAbility(state, enemy, ability, log)
+		}
+	}
+}
+
+// enemyHit fires the damaged or death abilities of an enemy that just went
+// from oldHP to its current HP
+func enemyHit(state *GameState, enemy *Enemy, oldHP uint8, log *EffectLog) {
+	switch {
+	case oldHP == 0 || enemy.HP >= oldHP:
+	case enemy.HP == 0:
+		triggerAbilities(state, enemy, OnDeath, log)
+	default:
+		triggerAbilities(state, enemy, OnDamaged, log)
+	}
+}
+
+// enemyRoundEndPhase ages every enemy by a round and fires its round end
+// abilities. Enemies brought in by these abilities wait for the next round.
+func enemyRoundEndPhase(state *GameState, log *EffectLog) {
+	used := len(log.Lines)
+	for _, enemyID := range sortedEnemyIDs(state) {
+		enemy := state.Enemies[enemyID]
+		if enemy.Rounds < 255 {
+			enemy.Rounds++
+		}
+		triggerAbilities(state, enemy, OnRoundEnd, log)
+	}
+	if len(log.Lines) == used {
+		log.Add("✅ No enemy abilities this round")
+	}
+}
+
+// useAbility carries out one of an enemy's abilities and logs what it did
+func useAbility(state *GameState, enemy *Enemy, ability EnemyAbility, log *EffectLog) {
+	name := getEnemyDisplayName(enemy.Type)
+
+	switch ability.Kind {
+	case AbilityDuplicate:
+		if enemy.HP == 0 || enemy.Rounds == 0 || int(enemy.Rounds)%ability.N != 0 {
+			return
+		}
+		if addAbilityEnemy(state, enemy.Type, enemy.Location) {
+			log.Add("🔁 %s in %s duplicates itself!", name, enemy.Location)
+		}
+
+	case AbilityRegenerate:
+		if enemy.HP == 0 || enemy.HP >= enemy.MaxHP {
+			return
+		}
+		oldHP := enemy.HP
+		healed := int(enemy.HP) + ability.N
+		if healed > int(enemy.MaxHP) {
+			healed = int(enemy.MaxHP)
+		}
+		enemy.HP = uint8(healed)
+		log.Add("💚 %s in %s regenerates! HP: %d → %d", name, enemy.Location, oldHP, enemy.HP)
+
+	case AbilitySpreadBugs:
+		neighbours := GetAdjacentRooms(enemy.Location)
+		oldBugs := make(map[RoomID]uint8)
+		for _, roomID := range neighbours {
+			if room := state.Rooms[roomID]; room != nil {
+				oldBugs[roomID] = room.BugMarkers
+			}
+		}
+		// Log the spill before any enemies it spawns log their own
+		spawnLog := NewEffectLog()
+		PlaceBugsInSpecificRooms(state, neighbours, uint8(ability.N), spawnLog)
+		for _, roomID := range neighbours {
+			if room := state.Rooms[roomID]; room != nil && room.BugMarkers != oldBugs[roomID] {
+				log.Add("🪲 %s bugs: %d → %d (spilled by %s)", roomID, oldBugs[roomID], room.BugMarkers, name)
+			}
+		}
+		log.Lines = append(log.Lines, spawnLog.Lines...)
+
+	case AbilitySummon:
+		summoned := 0
+		for i := 0; i < ability.N; i++ {
+			if addAbilityEnemy(state, ability.Summons, enemy.Location) {
+				summoned++
+			}
+		}
+		if summoned > 0 {
+			log.Add("📣 %s summons %d %s into %s!", name, summoned, getEnemyDisplayName(ability.Summons), enemy.Location)
+		}
+	}
+}
+
+// addAbilityEnemy brings in an enemy made by another enemy's ability. Its
+// own spawn abilities do not fire, so enemies cannot summon each other
+// endlessly.
+func addAbilityEnemy(state *GameState, enemyType EnemyType, location RoomID) bool {
+	base := fmt.Sprintf("%s_%d_SUMMON", enemyDef(enemyType).IDPrefix, state.Round)
+	enemyID := EnemyID(base)
+	for n := 2; state.Enemies[enemyID] != nil; n++ {
+		enemyID = EnemyID(fmt.Sprintf("%s_%d", base, n))
+	}
+
+	enemy, exists := newEnemy(enemyID, enemyType, location)
+	if !exists {
+		return false
+	}
+	state.Enemies[enemyID] = enemy
+	return true
+}
@@ -0,0 +1,152 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+// newAbilityTestGame starts a game on corruptionMap with no bugs aboard and
+// the given enemies
+//
+//	A B C
+//	D E F
+func newAbilityTestGame(t *testing.T, enemies ...*Enemy) GameState {
+	t.Helper()
+	withTestMap(t, corruptionMap)
+	state := Apply(GameState{}, InitializeGameAction{Seed: 1, PlayerClasses: []DevClass{Backend}}, NewEffectLog())
+	for _, room := range state.Rooms {
+		room.BugMarkers = 0
+		room.Corrupted = false
+	}
+	state.Enemies = make(map[EnemyID]*Enemy)
+	for _, enemy := range enemies {
+		state.Enemies[enemy.ID] = enemy
+	}
+	return state
+}
+
+func TestInfiniteLoopDuplicates(t *testing.T) {
+	state := newAbilityTestGame(t, &Enemy{ID: "E1", Type: InfiniteLoop, HP: 1, MaxHP: 1, Damage: 1, Location: "A"})
+
+	log := NewEffectLog()
+	for round := 1; round <= 3; round++ {
+		if len(state.Enemies) != 1 {
+			t.Fatalf("expected no copy before round 3, got %d enemies in round %d", len(state.Enemies), round)
+		}
+		enemyRoundEndPhase(&state, log)
+	}
+	if len(state.Enemies) != 2 {
+		t.Fatalf("expected the loop to duplicate after 3 rounds, got %d enemies", len(state.Enemies))
+	}
+	for id, enemy := range state.Enemies {
+		if id != "E1" && (enemy.Type != InfiniteLoop || enemy.Location != "A" || enemy.Rounds != 0) {
+			t.Errorf("expected a fresh Infinite Loop in A, got %+v", enemy)
+		}
+	}
+	if !strings.Contains(strings.Join(log.Lines, "\n"), "duplicates itself") {
+		t.Errorf("expected the duplication to be logged, got %v", log.Lines)
+	}
+}
+
+func TestStackOverflowSpillsBugsOnDeath(t *testing.T) {
+	state := newAbilityTestGame(t, &Enemy{ID: "E1", Type: StackOverflow, HP: 1, MaxHP: 3, Damage: 1, Location: "B"})
+	state.Players["P1"].Location = "B"
+
+	log := NewEffectLog()
+	state = ApplyCombat(state, MeleeAction{PlayerID: "P1"}, log)
+	if len(state.Enemies) != 0 {
+		t.Fatalf("expected the Stack Overflow to die, got %v", state.Enemies)
+	}
+	for _, id := range []RoomID{"A", "C", "E"} {
+		if bugs := state.Rooms[id].BugMarkers; bugs != 1 {
+			t.Errorf("expected 1 bug spilled into %s, got %d", id, bugs)
+		}
+	}
+	if bugs := state.Rooms["B"].BugMarkers + state.Rooms["D"].BugMarkers + state.Rooms["F"].BugMarkers; bugs != 0 {
+		t.Errorf("expected no bugs outside its neighbours, got %d", bugs)
+	}
+	if !strings.Contains(strings.Join(log.Lines, "\n"), "spilled by Stack Overflow") {
+		t.Errorf("expected the spill to be logged, got %v", log.Lines)
+	}
+
+	// Bugs spilled into a corrupted room draw from the spawn bag like any others
+	state = newAbilityTestGame(t, &Enemy{ID: "E1", Type: StackOverflow, HP: 1, MaxHP: 3, Damage: 1, Location: "B"})
+	state.Players["P1"].Location = "B"
+	state.Rooms["A"].BugMarkers, state.Rooms["A"].Corrupted = 3, true
+	state.SpawnBag = &SpawnBag{Tokens: []EnemyType{InfiniteLoop}}
+	state = ApplyCombat(state, MeleeAction{PlayerID: "P1"}, NewEffectLog())
+	if len(state.Enemies) != 1 {
+		t.Errorf("expected an enemy to spawn in the corrupted room, got %v", state.Enemies)
+	}
+	for _, enemy := range state.Enemies {
+		if enemy.Location != "A" {
+			t.Errorf("expected the spawn in A, got %s", enemy.Location)
+		}
+	}
+}
+
+func TestPythogorasSummonsAndRegenerates(t *testing.T) {
+	state := newAbilityTestGame(t, &Enemy{ID: "E1", Type: Pythogoras, HP: 6, MaxHP: 6, Damage: 1, Location: "F"})
+	state.Players["P1"].Location = "F"
+
+	state = ApplyCombat(state, MeleeAction{PlayerID: "P1"}, NewEffectLog())
+	boss := state.Enemies["E1"]
+	if boss.HP != 6-state.Players["P1"].Damage {
+		t.Fatalf("expected the hit to land, got HP %d", boss.HP)
+	}
+	if len(state.Enemies) != 2 {
+		t.Fatalf("expected an Infinite Loop summoned when hit, got %d enemies", len(state.Enemies))
+	}
+	for id, enemy := range state.Enemies {
+		if id != "E1" && (enemy.Type != InfiniteLoop || enemy.Location != "F") {
+			t.Errorf("expected the summoned loop in the escape room F, got %+v", enemy)
+		}
+	}
+
+	oldHP := boss.HP
+	enemyRoundEndPhase(&state, NewEffectLog())
+	if boss.HP != oldHP+1 {
+		t.Errorf("expected Pythogoras to regenerate 1 HP, got %d → %d", oldHP, boss.HP)
+	}
+	enemyRoundEndPhase(&state, NewEffectLog())
+	if boss.HP != boss.MaxHP {
+		t.Errorf("expected regeneration to stop at max HP, got %d/%d", boss.HP, boss.MaxHP)
+	}
+}
+
+func TestSpawnAbilities(t *testing.T) {
+	withTestEnemies(t, `
+enemies:
+  - type: 0
+    key: infinite_loop
+    name: Infinite Loop
+    abbrev: IL
+    id_prefix: LOOP
+    hp: 1
+    damage: 1
+    abilities:
+      - {on: spawn, do: spread_bugs, n: 2}
+      - {on: spawn, do: summon, enemy: infinite_loop, n: 1}
+`)
+	state := newAbilityTestGame(t)
+	state.Rooms["B"].Corrupted = true
+
+	corruptedRoomSpawnPhase(&state, NewEffectLog())
+	if len(state.Enemies) != 2 {
+		t.Errorf("expected the spawn and one summon that spawns nothing further, got %d enemies", len(state.Enemies))
+	}
+	for _, id := range []RoomID{"A", "C", "E"} {
+		if bugs := state.Rooms[id].BugMarkers; bugs != 2 {
+			t.Errorf("expected 2 bugs spread into %s on spawn, got %d", id, bugs)
+		}
+	}
+
+	// Enemies drawn from the bag when bugs land in a corrupted room log theirs too
+	state = newAbilityTestGame(t)
+	state.SpawnBag = &SpawnBag{Tokens: []EnemyType{InfiniteLoop}}
+	log := NewEffectLog()
+	SpawnEnemiesForCorruptedRooms(&state, []RoomID{"B"}, log)
+	if lines := strings.Join(log.Lines, "\n"); !strings.Contains(lines, "spilled by Infinite Loop") || !strings.Contains(lines, "summons 1 Infinite Loop") {
+		t.Errorf("expected the bag spawn's abilities to be logged, got %v", log.Lines)
+	}
+}
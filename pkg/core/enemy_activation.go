@@ -0,0 +1,92 @@
+package core
+
+// makeNoise records noise a player made in a room for enemies to hear when
+// they next activate
+func makeNoise(state *GameState, room RoomID, amount int) {
+	if amount <= 0 {
+		return
+	}
+	if state.Noise == nil {
+		state.Noise = make(map[RoomID]int)
+	}
+	state.Noise[room] += amount
+}
+
+// noiseHeard adds up the noise in every room within an enemy's hearing
+// range. Enemies with no hearing only notice noise in their own room.
+func noiseHeard(state *GameState, enemy *Enemy, def EnemyDef) int {
+	heard := 0
+	for room, noise := range state.Noise {
+		if room == enemy.Location {
+			heard += noise
+		} else if def.Hearing > 0 && CanTraverse(state, PathQuery{From: enemy.Location, To: room, MaxSteps: def.Hearing}).Valid {
+			heard += noise
+		}
+	}
+	return heard
+}
+
+// enemyAlerted reports whether an enemy heard enough noise to go hunting
+func enemyAlerted(state *GameState, enemy *Enemy, def EnemyDef) bool {
+	return def.Alertness == 0 || noiseHeard(state, enemy, def) >= def.Alertness
+}
+
+// enemyActivationPhase has every enemy act once, in ID order: it attacks the
+// developers in its room, hunts its target if it heard enough noise (see
+// ActionNoise and EnemyDef.Alertness), or lurks. The ship then falls quiet
+// until the players make noise again.
+func enemyActivationPhase(state *GameState, log *EffectLog) {
+	attacked, breaking, lurking := 0, 0, 0
+	for _, enemyID := range sortedEnemyIDs(state) {
+		enemy := state.Enemies[enemyID]
+		def := enemyDef(enemy.Type)
+		if enemyAttack(state, enemy, log) {
+			attacked++
+			continue
+		}
+		if def.Speed == 0 || !enemyAlerted(state, enemy, def) {
+			lurking++
+			continue
+		}
+		moved, brokeDoor := advanceEnemy(state, enemy, def.Speed, log)
+		switch {
+		case moved:
+		case brokeDoor:
+			breaking++
+		default:
+			lurking++
+		}
+	}
+	if attacked == 0 {
+		log.Add("✅ No co-located enemies - players are safe")
+	}
+	if breaking > 0 {
+		log.Add("🚪 %d enemies spent their move on a door", breaking)
+	}
+	if lurking > 0 {
+		log.Add("🫥 %d enemies lurk where they are", lurking)
+	}
+	state.Noise = nil
+}
+
+// enemyAttack has an enemy attack every living developer in its room,
+// returning false if there was nobody to attack
+func enemyAttack(state *GameState, enemy *Enemy, log *EffectLog) bool {
+	attacked := false
+	for _, playerID := range seatOrder(state) {
+		player := state.Players[playerID]
+		if player.Location == enemy.Location && player.HP > 0 {
+			// Apply enemy damage
+			oldHP := player.HP
+			damage := enemy.Damage
+			if player.HP <= damage {
+				player.HP = 0
+			} else {
+				player.HP -= damage
+			}
+			log.Add("💔 %s attacks %s in %s! HP: %d → %d", getEnemyDisplayName(enemy.Type), player.ID, player.Location, oldHP, player.HP)
+			attacked = true
+		}
+	}
+	return attacked
+}
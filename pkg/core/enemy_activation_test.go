@@ -0,0 +1,80 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPlayerActionsMakeNoise(t *testing.T) {
+	state := newAbilityTestGame(t)
+	for _, room := range state.Rooms {
+		room.Explored = true
+	}
+
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "B"}, NewEffectLog())
+	state = Apply(state, MeleeAction{PlayerID: "P1"}, NewEffectLog())
+	if want := ActionNoise.Move + ActionNoise.Melee; state.Noise["B"] != want {
+		t.Errorf("expected %d noise in B from the move and the melee, got %v", want, state.Noise)
+	}
+	if state.Noise["C"] != 0 {
+		t.Errorf("expected no noise left behind in C, got %v", state.Noise)
+	}
+}
+
+func TestEnemyActivation(t *testing.T) {
+	tests := []struct {
+		name   string
+		enemy  EnemyType
+		from   RoomID
+		noise  map[RoomID]int
+		want   RoomID
+		attack bool
+	}{
+		{"lurks in silence", InfiniteLoop, "A", nil, "A", false},
+		{"hunts noise next door", InfiniteLoop, "A", map[RoomID]int{"B": 1}, "B", false},
+		{"cannot hear that far", InfiniteLoop, "A", map[RoomID]int{"C": 5}, "A", false},
+		{"too quiet to stir", StackOverflow, "A", map[RoomID]int{"B": 1}, "A", false},
+		{"hunts loud noise", StackOverflow, "A", map[RoomID]int{"C": 3}, "B", false},
+		{"attacks instead of moving", InfiniteLoop, "C", map[RoomID]int{"B": 9}, "C", true},
+		{"always heads for the escape", Pythogoras, "E", nil, "F", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := EnemyDB[tt.enemy]
+			state := newAbilityTestGame(t, &Enemy{ID: "E1", Type: tt.enemy, HP: def.HP, MaxHP: def.HP, Damage: def.Damage, Location: tt.from})
+			state.Noise = tt.noise
+			hp := state.Players["P1"].HP
+
+			log := NewEffectLog()
+			enemyActivationPhase(&state, log)
+			if loc := state.Enemies["E1"].Location; loc != tt.want {
+				t.Errorf("expected the enemy in %s, got %s", tt.want, loc)
+			}
+			if attacked := state.Players["P1"].HP < hp; attacked != tt.attack {
+				t.Errorf("expected attack %v, P1 HP %d → %d", tt.attack, hp, state.Players["P1"].HP)
+			}
+			if state.Noise != nil {
+				t.Errorf("expected the noise spent, got %v", state.Noise)
+			}
+			if lurked := strings.Contains(strings.Join(log.Lines, "\n"), "lurk"); lurked != (tt.want == tt.from && !tt.attack) {
+				t.Errorf("expected lurking logged only for enemies that stay put, got %v", log.Lines)
+			}
+		})
+	}
+}
+
+func TestEnemyBreakingDoorDoesNotLurk(t *testing.T) {
+	state := newAbilityTestGame(t, &Enemy{ID: "E1", Type: InfiniteLoop, HP: 1, MaxHP: 1, Damage: 1, Location: "B"})
+	state.Doors = map[Edge]DoorState{EdgeBetween("B", "C"): DoorClosed}
+	state.Noise = map[RoomID]int{"C": 1}
+
+	log := NewEffectLog()
+	enemyActivationPhase(&state, log)
+	lines := strings.Join(log.Lines, "\n")
+	if state.Enemies["E1"].Location != "B" || state.Doors[EdgeBetween("B", "C")] == DoorClosed {
+		t.Errorf("expected the loop to stay in B working on the door, got %s with the door %q", state.Enemies["E1"].Location, state.Doors[EdgeBetween("B", "C")])
+	}
+	if !strings.Contains(lines, "1 enemies spent their move on a door") || strings.Contains(lines, "lurk") {
+		t.Errorf("expected the door counted apart from lurking, got %v", log.Lines)
+	}
+}
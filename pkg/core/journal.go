@@ -0,0 +1,348 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// JournalVersion is the format version written in journal start entries
+const JournalVersion = 1
+
+// JournalEntryKind identifies what a journal line records
+type JournalEntryKind string
+
+const (
+	EntryStart  JournalEntryKind = "start"  // Snapshot of the state the following entries apply to
+	EntryAction JournalEntryKind = "action" // An Action passed to Apply
+	EntryPhase  JournalEntryKind = "phase"  // A round phase boundary driven by the game loop
+)
+
+// PhaseStep names a phase boundary recorded in the journal
+type PhaseStep string
+
+const (
+	StepDraw        PhaseStep = "draw"
+	StepAdvanceTurn PhaseStep = "advance_turn"
+	StepEvent       PhaseStep = "event"
+	StepMaintenance PhaseStep = "maintenance"
+)
+
+// JournalEntry is one JSON line of the journal. Every entry carries the hash
+// of the state after it was applied so replays can detect divergence.
+type JournalEntry struct {
+	Seq        int              `json:"seq"`
+	Kind       JournalEntryKind `json:"kind"`
+	Version    int              `json:"version,omitempty"`     // start: journal format version
+	State      json.RawMessage  `json:"state,omitempty"`       // start: SaveGameState output
+	ActionType string           `json:"action_type,omitempty"` // action: Go type name
+	Action     json.RawMessage  `json:"action,omitempty"`      // action: encoded action fields
+	Cost       int              `json:"cost,omitempty"`        // action: actions spent from ActionsLeft
+	Phase      PhaseStep        `json:"phase,omitempty"`       // phase: which boundary
+	Checkpoint string           `json:"checkpoint"`            // StateHash after this entry
+}
+
+// Journal writes game history as JSON Lines
+type Journal struct {
+	enc *json.Encoder
+	seq int
+}
+
+// NewJournal creates a journal writing to w
+func NewJournal(w io.Writer) *Journal {
+	return &Journal{enc: json.NewEncoder(w)}
+}
+
+// RecordStart snapshots the state that subsequent entries build on.
+// It is written at game start and again whenever a save is loaded mid-game.
+func (j *Journal) RecordStart(state *GameState) error {
+	data, err := SaveGameState(state)
+	if err != nil {
+		return err
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, data); err != nil {
+		return fmt.Errorf("failed to compact start snapshot: %w", err)
+	}
+	return j.write(JournalEntry{Kind: EntryStart, Version: JournalVersion, State: compact.Bytes()}, state)
+}
+
+// RecordAction records an action applied to the state and the actions it cost
+func (j *Journal) RecordAction(action Action, cost int, state *GameState) error {
+	name, data, err := EncodeAction(action)
+	if err != nil {
+		return err
+	}
+	return j.write(JournalEntry{Kind: EntryAction, ActionType: name, Action: data, Cost: cost}, state)
+}
+
+// RecordPhase records a phase boundary run by the game loop
+func (j *Journal) RecordPhase(step PhaseStep, state *GameState) error {
+	return j.write(JournalEntry{Kind: EntryPhase, Phase: step}, state)
+}
+
+func (j *Journal) write(entry JournalEntry, state *GameState) error {
+	hash, err := StateHash(state)
+	if err != nil {
+		return err
+	}
+	j.seq++
+	entry.Seq = j.seq
+	entry.Checkpoint = hash
+	if err := j.enc.Encode(entry); err != nil {
+		return fmt.Errorf("failed to write journal entry %d: %w", entry.Seq, err)
+	}
+	return nil
+}
+
+// StateHash fingerprints a game state for journal checkpoints
+func StateHash(state *GameState) (string, error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash game state: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// EncodeAction returns the journal type name and JSON fields of an action
+func EncodeAction(action Action) (string, json.RawMessage, error) {
+	name := actionName(action)
+	if name == "" {
+		return "", nil, fmt.Errorf("cannot journal action type %T", action)
+	}
+
+	data, err := json.Marshal(action)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode %s: %w", name, err)
+	}
+	return name, data, nil
+}
+
+// actionName returns the stable type name of an action, or "" for unknown types
+func actionName(action Action) string {
+	switch action.(type) {
+	case MoveAction:
+		return "MoveAction"
+	case AnswerQuestionAction:
+		return "AnswerQuestionAction"
+	case SkipQuestionAction:
+		return "SkipQuestionAction"
+	case OpenDoorAction:
+		return "OpenDoorAction"
+	case SearchAction:
+		return "SearchAction"
+	case ShootAction:
+		return "ShootAction"
+	case MeleeAction:
+		return "MeleeAction"
+	case RoomAction:
+		return "RoomAction"
+	case SpecialAction:
+		return "SpecialAction"
+	case PlayCardAction:
+		return "PlayCardAction"
+	case PassAction:
+		return "PassAction"
+	case InitializeGameAction:
+		return "InitializeGameAction"
+	case GiveSpecialCardAction:
+		return "GiveSpecialCardAction"
+	}
+	return ""
+}
+
+// DecodeAction rebuilds an action from its journal type name and JSON fields
+func DecodeAction(name string, data json.RawMessage) (Action, error) {
+	var action Action
+	var err error
+	switch name {
+	case "MoveAction":
+		var a MoveAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	case "AnswerQuestionAction":
+		var a AnswerQuestionAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	case "SkipQuestionAction":
+		var a SkipQuestionAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	case "OpenDoorAction":
+		var a OpenDoorAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	case "SearchAction":
+		var a SearchAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	case "ShootAction":
+		var a ShootAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	case "MeleeAction":
+		var a MeleeAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	case "RoomAction":
+		var a RoomAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	case "SpecialAction":
+		var a SpecialAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	case "PlayCardAction":
+		var a PlayCardAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	case "PassAction":
+		var a PassAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	case "InitializeGameAction":
+		var a InitializeGameAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	case "GiveSpecialCardAction":
+		var a GiveSpecialCardAction
+		err = json.Unmarshal(data, &a)
+		action = a
+	default:
+		return nil, fmt.Errorf("unknown action type: %s", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", name, err)
+	}
+	return action, nil
+}
+
+// ReplayDivergenceError reports a replayed state that no longer matches the journal
+type ReplayDivergenceError struct {
+	Seq      int
+	Kind     JournalEntryKind
+	Expected string
+	Got      string
+}
+
+func (e *ReplayDivergenceError) Error() string {
+	return fmt.Sprintf("replay diverged at entry %d (%s): checkpoint %s, recomputed %s", e.Seq, e.Kind, e.Expected, e.Got)
+}
+
+// Replayer re-applies journal entries and verifies every checkpoint
+type Replayer struct {
+	state   *GameState
+	lastSeq int
+	info    SaveInfo
+}
+
+// NewReplayer creates a replayer; the first entry it steps must be a start entry
+func NewReplayer() *Replayer {
+	return &Replayer{}
+}
+
+// State returns the replayed state, or nil before the first start entry
+func (r *Replayer) State() *GameState {
+	return r.state
+}
+
+// StartInfo reports how the most recent start snapshot was loaded
+func (r *Replayer) StartInfo() SaveInfo {
+	return r.info
+}
+
+// Step applies one journal entry, writing its effects to log
+func (r *Replayer) Step(entry JournalEntry, log *EffectLog) error {
+	if entry.Seq != r.lastSeq+1 {
+		return fmt.Errorf("journal entry %d out of sequence (expected %d)", entry.Seq, r.lastSeq+1)
+	}
+	r.lastSeq = entry.Seq
+
+	if entry.Kind == EntryStart {
+		if entry.Version > JournalVersion {
+			return fmt.Errorf("journal version %d is newer than supported version %d", entry.Version, JournalVersion)
+		}
+		state, info, err := LoadSave(entry.State)
+		if err != nil {
+			return fmt.Errorf("journal entry %d: %w", entry.Seq, err)
+		}
+		state.ScratchLog = NewEffectLog()
+		r.state = state
+		r.info = info
+		return r.verify(entry)
+	}
+
+	if r.state == nil {
+		return fmt.Errorf("journal entry %d (%s) precedes the start snapshot", entry.Seq, entry.Kind)
+	}
+
+	switch entry.Kind {
+	case EntryAction:
+		action, err := DecodeAction(entry.ActionType, entry.Action)
+		if err != nil {
+			return fmt.Errorf("journal entry %d: %w", entry.Seq, err)
+		}
+		// Spend the cost afterwards, as the game loop does: moves into
+		// corrupted rooms are priced from the actions left before them
+		newState := Apply(*r.state, action, log)
+		newState.ActionsLeft -= entry.Cost
+		r.state = &newState
+	case EntryPhase:
+		switch entry.Phase {
+		case StepDraw:
+			DrawPhase(r.state)
+		case StepAdvanceTurn:
+			AdvanceTurn(r.state)
+		case StepEvent:
+			EventPhase(r.state, log)
+		case StepMaintenance:
+			EndRoundMaintenance(r.state)
+		default:
+			return fmt.Errorf("journal entry %d: unknown phase %q", entry.Seq, entry.Phase)
+		}
+	default:
+		return fmt.Errorf("journal entry %d: unknown kind %q", entry.Seq, entry.Kind)
+	}
+
+	return r.verify(entry)
+}
+
+func (r *Replayer) verify(entry JournalEntry) error {
+	hash, err := StateHash(r.state)
+	if err != nil {
+		return err
+	}
+	if hash != entry.Checkpoint {
+		return &ReplayDivergenceError{Seq: entry.Seq, Kind: entry.Kind, Expected: entry.Checkpoint, Got: hash}
+	}
+	return nil
+}
+
+// ReadJournalEntries decodes a JSON Lines journal, calling fn for each entry in order
+func ReadJournalEntries(r io.Reader, fn func(JournalEntry) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024) // Start snapshots are long lines
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry JournalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return fmt.Errorf("journal line %d: %w", line, err)
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read journal: %w", err)
+	}
+	return nil
+}
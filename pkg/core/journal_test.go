@@ -0,0 +1,227 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newJournalTestGameState() GameState {
+	state := newHotSeatTestGameState()
+	state.Rooms["R07"] = &RoomState{ID: "R07", Type: AmmoCache}
+	state.Rooms["R11"] = &RoomState{ID: "R11", Type: Empty, Explored: true}
+	state.Rooms["R13"] = &RoomState{ID: "R13", Type: MedBay}
+	state.QuestionOrder = []int{3, 0}
+	state.SpawnBag = &SpawnBag{Tokens: []EnemyType{InfiniteLoop}}
+	return state
+}
+
+// recordJournalTestGame plays a short hot-seat round the way the CLI does,
+// journaling every step, and returns the final state.
+func recordJournalTestGame(t *testing.T, journal *Journal) GameState {
+	t.Helper()
+	state := newJournalTestGameState()
+	record := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("journal write failed: %v", err)
+		}
+	}
+
+	record(journal.RecordStart(&state))
+	DrawPhase(&state)
+	record(journal.RecordPhase(StepDraw, &state))
+
+	// P1: correct answer into R07
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+	state.ActionsLeft--
+	record(journal.RecordAction(MoveAction{PlayerID: "P1", To: "R07"}, 1, &state))
+	right := scriptedAnswer(QuestionDB[3], "P1", true)
+	state = Apply(state, right, NewEffectLog())
+	record(journal.RecordAction(right, 0, &state))
+	AdvanceTurn(&state)
+	record(journal.RecordPhase(StepAdvanceTurn, &state))
+
+	// P2: explored move, then pass
+	state = Apply(state, MoveAction{PlayerID: "P2", To: "R11"}, NewEffectLog())
+	state.ActionsLeft--
+	record(journal.RecordAction(MoveAction{PlayerID: "P2", To: "R11"}, 1, &state))
+	state = Apply(state, PassAction{PlayerID: "P2"}, NewEffectLog())
+	record(journal.RecordAction(PassAction{PlayerID: "P2"}, 0, &state))
+	AdvanceTurn(&state)
+	record(journal.RecordPhase(StepAdvanceTurn, &state))
+
+	// P3: the move asks a question, and the wrong answer spreads bugs
+	state = Apply(state, MoveAction{PlayerID: "P3", To: "R13"}, NewEffectLog())
+	state.ActionsLeft--
+	record(journal.RecordAction(MoveAction{PlayerID: "P3", To: "R13"}, 1, &state))
+	wrong := scriptedAnswer(QuestionDB[0], "P3", false)
+	state = Apply(state, wrong, NewEffectLog())
+	record(journal.RecordAction(wrong, 0, &state))
+	AdvanceTurn(&state)
+	record(journal.RecordPhase(StepAdvanceTurn, &state))
+
+	EndRoundMaintenance(&state)
+	record(journal.RecordPhase(StepMaintenance, &state))
+	return state
+}
+
+func replayJournal(data []byte) (*Replayer, error) {
+	replayer := NewReplayer()
+	err := ReadJournalEntries(bytes.NewReader(data), func(entry JournalEntry) error {
+		return replayer.Step(entry, NewEffectLog())
+	})
+	return replayer, err
+}
+
+func TestJournalReplayReproducesFinalState(t *testing.T) {
+	withTestCardDB(t)
+	withTestQuestions(t)
+	var buf bytes.Buffer
+	final := recordJournalTestGame(t, NewJournal(&buf))
+
+	if lines := strings.Count(buf.String(), "\n"); lines != 12 {
+		t.Errorf("expected 12 journal lines, got %d", lines)
+	}
+
+	replayer, err := replayJournal(buf.Bytes())
+	if err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+
+	want, _ := StateHash(&final)
+	got, _ := StateHash(replayer.State())
+	if want != got {
+		t.Errorf("replayed state hash %s, want %s", got, want)
+	}
+	if replayer.State().Players["P2"].Location != "R11" {
+		t.Errorf("expected P2 in R11 after replay, got %s", replayer.State().Players["P2"].Location)
+	}
+	if replayer.State().NextQuestion != 2 {
+		t.Errorf("expected both questions consumed, NextQuestion=%d", replayer.State().NextQuestion)
+	}
+}
+
+func TestJournalReplaysCorruptedRoomMove(t *testing.T) {
+	withTestQuestions(t)
+	state := newCorruptionTestGameState(2)
+	move := MoveAction{PlayerID: "P1", To: "R07"}
+
+	// Priced before applying and spent after, as the CLI does
+	var buf bytes.Buffer
+	journal := NewJournal(&buf)
+	if err := journal.RecordStart(&state); err != nil {
+		t.Fatal(err)
+	}
+	cost := ActionCost(&state, move)
+	state = Apply(state, move, NewEffectLog())
+	state.ActionsLeft -= cost
+	if err := journal.RecordAction(move, cost, &state); err != nil {
+		t.Fatal(err)
+	}
+	if cost != 2 || state.Players["P1"].Location != "R07" || state.PendingQuestion != nil {
+		t.Fatalf("expected a 2-action move straight into R07, got cost %d, %s, %+v", cost, state.Players["P1"].Location, state.PendingQuestion)
+	}
+
+	replayer, err := replayJournal(buf.Bytes())
+	if err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+	if got := replayer.State(); got.Players["P1"].Location != "R07" || got.PendingQuestion != nil || got.ActionsLeft != 0 {
+		t.Errorf("expected the replay to end in R07 with no actions left, got %s, %+v, %d actions",
+			got.Players["P1"].Location, got.PendingQuestion, got.ActionsLeft)
+	}
+}
+
+func TestJournalReplayDetectsDivergence(t *testing.T) {
+	withTestCardDB(t)
+	withTestQuestions(t)
+	var buf bytes.Buffer
+	recordJournalTestGame(t, NewJournal(&buf))
+
+	// Tamper with the recorded answer so P1 now answers wrongly
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	var entry JournalEntry
+	if err := json.Unmarshal([]byte(lines[3]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.ActionType != "AnswerQuestionAction" {
+		t.Fatalf("expected line 4 to be an answer, got %s", entry.ActionType)
+	}
+	_, entry.Action, _ = EncodeAction(scriptedAnswer(QuestionDB[3], "P1", false))
+	tampered, _ := json.Marshal(entry)
+	lines[3] = string(tampered)
+
+	_, err := replayJournal([]byte(strings.Join(lines, "\n")))
+	var divergence *ReplayDivergenceError
+	if !errors.As(err, &divergence) {
+		t.Fatalf("expected ReplayDivergenceError, got %v", err)
+	}
+	if divergence.Seq != 4 {
+		t.Errorf("expected divergence at entry 4, got %d", divergence.Seq)
+	}
+}
+
+func TestJournalReplayRequiresStartEntry(t *testing.T) {
+	entry := JournalEntry{Seq: 1, Kind: EntryPhase, Phase: StepDraw}
+	if err := NewReplayer().Step(entry, NewEffectLog()); err == nil {
+		t.Error("expected error replaying a phase before the start snapshot")
+	}
+}
+
+func TestEncodeDecodeActionRoundTrip(t *testing.T) {
+	actions := []Action{
+		MoveAction{PlayerID: "P1", To: "R07"},
+		AnswerQuestionAction{PlayerID: "P1", Choice: 2},
+		AnswerQuestionAction{PlayerID: "P1", Choices: []int{0, 2}},
+		OpenDoorAction{PlayerID: "P1", To: "R07"},
+		AnswerQuestionAction{PlayerID: "P1", Text: "make"},
+		SkipQuestionAction{PlayerID: "P1"},
+		SearchAction{PlayerID: "P1"},
+		ShootAction{PlayerID: "P2"},
+		MeleeAction{PlayerID: "P2"},
+		RoomAction{PlayerID: "P1"},
+		PlayCardAction{PlayerID: "P1", CardID: "CARD_1"},
+		PassAction{PlayerID: "P3"},
+		GiveSpecialCardAction{PlayerID: "P1"},
+		InitializeGameAction{Seed: 7, PlayerClasses: []DevClass{Frontend, DevOps}},
+	}
+
+	for _, action := range actions {
+		name, data, err := EncodeAction(action)
+		if err != nil {
+			t.Fatalf("encode %T: %v", action, err)
+		}
+		decoded, err := DecodeAction(name, data)
+		if err != nil {
+			t.Fatalf("decode %s: %v", name, err)
+		}
+		reencoded, _ := json.Marshal(decoded)
+		if string(reencoded) != string(data) {
+			t.Errorf("%s round trip: got %s, want %s", name, reencoded, data)
+		}
+	}
+
+	if _, _, err := EncodeAction(invalidAction{}); err == nil {
+		t.Error("expected error encoding an unknown action type")
+	}
+}
+
+func TestPassActionEndsTurn(t *testing.T) {
+	state := newHotSeatTestGameState()
+	state.ActionsLeft = 2
+	log := NewEffectLog()
+
+	newState := Apply(state, PassAction{PlayerID: "P1"}, log)
+	if newState.ActionsLeft != 0 {
+		t.Errorf("expected ActionsLeft 0 after pass, got %d", newState.ActionsLeft)
+	}
+	if state.ActionsLeft != 2 {
+		t.Error("PassAction must not mutate the input state")
+	}
+	if log.IsEmpty() {
+		t.Error("expected pass to be logged")
+	}
+}
@@ -0,0 +1,150 @@
+package core
+
+import "sort"
+
+// MaxLearningBox is the Leitner box of a mastered question
+const MaxLearningBox = 5
+
+// AnsweredQuestion is one coding question answered during the game
+type AnsweredQuestion struct {
+	PlayerID   PlayerID
+	QuestionID int
+	Correct    bool
+}
+
+// LearningProfile is a player's answer history across games. Questions are
+// scheduled with spaced repetition: every correct answer moves a question up
+// one Leitner box and doubles the number of games until it is due again,
+// while a miss drops it back to box 0 so it comes back next game.
+type LearningProfile struct {
+	Name      string                   `json:"name"`
+	Games     int                      `json:"games"` // Finished games; the clock Due is measured in
+	Questions map[int]*QuestionHistory `json:"questions"`
+}
+
+// QuestionHistory is how a player has done on one question
+type QuestionHistory struct {
+	Box     int `json:"box"` // 0 = still learning, MaxLearningBox = mastered
+	Seen    int `json:"seen"`
+	Correct int `json:"correct"`
+	Due     int `json:"due"` // First game number the question should be asked again
+}
+
+// NewLearningProfile creates an empty profile
+func NewLearningProfile(name string) *LearningProfile {
+	return &LearningProfile{Name: name, Questions: make(map[int]*QuestionHistory)}
+}
+
+// Record adds one answer to the profile
+func (p *LearningProfile) Record(questionID int, correct bool) {
+	if p.Questions == nil {
+		p.Questions = make(map[int]*QuestionHistory)
+	}
+	history, exists := p.Questions[questionID]
+	if !exists {
+		history = &QuestionHistory{}
+		p.Questions[questionID] = history
+	}
+
+	history.Seen++
+	if correct {
+		history.Correct++
+		if history.Box < MaxLearningBox {
+			history.Box++
+		}
+		history.Due = p.Games + 1<<history.Box
+	} else {
+		history.Box = 0
+		history.Due = p.Games + 1
+	}
+}
+
+// RecordGame adds a player's answers from a finished game and advances the
+// profile's clock
+func (p *LearningProfile) RecordGame(state *GameState, playerID PlayerID) {
+	for _, answer := range state.AnswerHistory {
+		if answer.PlayerID == playerID {
+			p.Record(answer.QuestionID, answer.Correct)
+		}
+	}
+	p.Games++
+}
+
+// DueCount returns how many known questions are due for review
+func (p *LearningProfile) DueCount() int {
+	count := 0
+	for id, history := range p.Questions {
+		if _, exists := QuestionDB[id]; exists && history.Due <= p.Games {
+			count++
+		}
+	}
+	return count
+}
+
+// scheduleKey ranks a question for one profile; lower keys are asked sooner.
+// Due reviews come first (weakest box first), then questions never seen,
+// then the rest in order of when they fall due.
+func (p *LearningProfile) scheduleKey(questionID int) [2]int {
+	history, exists := p.Questions[questionID]
+	switch {
+	case !exists:
+		return [2]int{1, 0}
+	case history.Due <= p.Games:
+		return [2]int{0, history.Box}
+	default:
+		return [2]int{2, history.Due - p.Games}
+	}
+}
+
+// ScheduleQuestions reorders a shuffled question order for the players'
+// profiles. Each question takes its most urgent rank across the profiles,
+// and ties keep their shuffled order so the result stays deterministic
+// under the game seed. With no history the order is unchanged.
+func ScheduleQuestions(order []int, profiles ...*LearningProfile) []int {
+	keys := make(map[int][2]int, len(order))
+	for _, id := range order {
+		key := [2]int{1, 0}
+		for i, profile := range profiles {
+			if candidate := profile.scheduleKey(id); i == 0 || lessKey(candidate, key) {
+				key = candidate
+			}
+		}
+		keys[id] = key
+	}
+
+	scheduled := append([]int(nil), order...)
+	sort.SliceStable(scheduled, func(i, j int) bool {
+		return lessKey(keys[scheduled[i]], keys[scheduled[j]])
+	})
+	return scheduled
+}
+
+func lessKey(a, b [2]int) bool {
+	if a[0] != b[0] {
+		return a[0] < b[0]
+	}
+	return a[1] < b[1]
+}
+
+// LearningSummary is what a player got right and wrong in one game
+type LearningSummary struct {
+	Learned []Question // Answered correctly
+	Review  []Question // Missed; worth another look
+}
+
+// SummarizeLearning collects a player's answered questions from the game
+func SummarizeLearning(state *GameState, playerID PlayerID) LearningSummary {
+	var summary LearningSummary
+	for _, answer := range state.AnswerHistory {
+		question, exists := QuestionDB[answer.QuestionID]
+		if answer.PlayerID != playerID || !exists {
+			continue
+		}
+		if answer.Correct {
+			summary.Learned = append(summary.Learned, question)
+		} else {
+			summary.Review = append(summary.Review, question)
+		}
+	}
+	return summary
+}
@@ -0,0 +1,90 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLearningProfileRecord(t *testing.T) {
+	profile := NewLearningProfile("ada")
+	profile.Games = 3
+
+	profile.Record(7, true)
+	profile.Record(7, true)
+	if history := profile.Questions[7]; history.Box != 2 || history.Due != 3+4 || history.Seen != 2 || history.Correct != 2 {
+		t.Errorf("expected box 2 due at game 7, got %+v", history)
+	}
+
+	profile.Record(7, false)
+	if history := profile.Questions[7]; history.Box != 0 || history.Due != 4 || history.Seen != 3 {
+		t.Errorf("expected a miss to reset to box 0 due next game, got %+v", history)
+	}
+
+	for i := 0; i < MaxLearningBox+2; i++ {
+		profile.Record(8, true)
+	}
+	if box := profile.Questions[8].Box; box != MaxLearningBox {
+		t.Errorf("expected the box to stop at %d, got %d", MaxLearningBox, box)
+	}
+}
+
+func TestScheduleQuestions(t *testing.T) {
+	profile := NewLearningProfile("ada")
+	profile.Games = 10
+	profile.Questions = map[int]*QuestionHistory{
+		1: {Box: 2, Due: 10}, // Due review
+		2: {Box: 0, Due: 9},  // Missed, overdue
+		3: {Box: 5, Due: 40}, // Mastered
+		4: {Box: 3, Due: 12}, // Not due yet
+	}
+	order := []int{3, 5, 4, 1, 6, 2}
+
+	got := ScheduleQuestions(order, profile)
+	if want := []int{2, 1, 5, 6, 4, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if !reflect.DeepEqual(order, []int{3, 5, 4, 1, 6, 2}) {
+		t.Error("ScheduleQuestions changed its input")
+	}
+	if got := ScheduleQuestions(order); !reflect.DeepEqual(got, order) {
+		t.Errorf("expected no profiles to keep the order, got %v", got)
+	}
+
+	// Another player who missed question 3 pulls it forward for the team
+	other := NewLearningProfile("grace")
+	other.Questions = map[int]*QuestionHistory{3: {Box: 0, Due: 0}}
+	if got := ScheduleQuestions(order, profile, other); got[0] != 3 {
+		t.Errorf("expected question 3 first for the team, got %v", got)
+	}
+}
+
+func TestAnswersFeedProfileAndSummary(t *testing.T) {
+	withTestQuestions(t)
+	withTestCardDB(t)
+	state := newQuestionTestGameState()
+
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+	state = Apply(state, AnswerQuestionAction{PlayerID: "P1", Choice: QuestionDB[3].CorrectAnswer}, NewEffectLog())
+	want := []AnsweredQuestion{{PlayerID: "P1", QuestionID: 3, Correct: true}}
+	if !reflect.DeepEqual(state.AnswerHistory, want) {
+		t.Fatalf("expected answer history %v, got %v", want, state.AnswerHistory)
+	}
+
+	state.AnswerHistory = append(state.AnswerHistory,
+		AnsweredQuestion{PlayerID: "P1", QuestionID: 0, Correct: false},
+		AnsweredQuestion{PlayerID: "P2", QuestionID: 5, Correct: true},
+	)
+	summary := SummarizeLearning(&state, "P1")
+	if len(summary.Learned) != 1 || summary.Learned[0].ID != 3 || len(summary.Review) != 1 || summary.Review[0].ID != 0 {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+
+	profile := NewLearningProfile("ada")
+	profile.RecordGame(&state, "P1")
+	if profile.Games != 1 || len(profile.Questions) != 2 || profile.Questions[0].Due != 1 {
+		t.Errorf("expected P1's two answers recorded, got %+v", profile)
+	}
+	if due := profile.DueCount(); due != 1 {
+		t.Errorf("expected the missed question due next game, got %d", due)
+	}
+}
@@ -0,0 +1,125 @@
+package core
+
+// ActionOption is one candidate action for the active player
+type ActionOption struct {
+	Action        Action
+	Err           error // nil when legal, otherwise the *ActionError explaining why not
+	NeedsQuestion bool  // Moves into unexplored or corrupted rooms, and sprints across unexplored ones, are gated by a coding question
+	NeedsText     bool  // Answer to a text question; the player fills in Text
+	Cost          int   // Actions spent from ActionsLeft (see ActionCost)
+}
+
+// Legal reports whether the option may be taken now
+func (o ActionOption) Legal() bool {
+	return o.Err == nil
+}
+
+// Reason is a short explanation of why the option is disabled, or "" if legal
+func (o ActionOption) Reason() string {
+	if o.Err == nil {
+		return ""
+	}
+	return o.Err.Error()
+}
+
+// LegalActions lists every action the active player could take - a move per
+// neighbouring room, an open door per shut door out of the room, a card play
+// per card in hand (per reachable room for movement cards), search, shoot,
+// melee, room action and pass - each marked legal or carrying the reason it
+// is disabled. While a question is pending the only options are its answers:
+// one per option, one per non-empty set of options for multi questions, or a
+// single NeedsText template for text questions. The order is stable so
+// frontends can number the options.
+func LegalActions(state *GameState) []ActionOption {
+	player := GetActivePlayer(state)
+	if player == nil {
+		return nil
+	}
+
+	var options []ActionOption
+	add := func(action Action, needsQuestion bool) {
+		options = append(options, ActionOption{
+			Action:        action,
+			Err:           ValidateAction(state, action),
+			NeedsQuestion: needsQuestion,
+			Cost:          ActionCost(state, action),
+		})
+	}
+
+	if question, pending := CurrentQuestion(state); pending {
+		switch question.Kind {
+		case MultiQuestion:
+			for set := 1; set < 1<<len(question.Options); set++ {
+				answer := AnswerQuestionAction{PlayerID: player.ID}
+				for choice := range question.Options {
+					if set&(1<<choice) != 0 {
+						answer.Choices = append(answer.Choices, choice)
+					}
+				}
+				add(answer, false)
+			}
+		case TextQuestion:
+			options = append(options, ActionOption{Action: AnswerQuestionAction{PlayerID: player.ID}, NeedsText: true})
+		default:
+			for choice := range question.Options {
+				add(AnswerQuestionAction{PlayerID: player.ID, Choice: choice}, false)
+			}
+		}
+		if player.QuestionSkips > 0 {
+			add(SkipQuestionAction{PlayerID: player.ID}, false)
+		}
+		return options
+	}
+
+	questionsLeft := PeekQuestion(state).ID != -1
+	for _, roomID := range GetAdjacentRooms(player.Location) {
+		room, exists := state.Rooms[roomID]
+		if !exists {
+			continue
+		}
+		move := MoveAction{PlayerID: player.ID, To: roomID}
+		add(move, (!room.Explored && questionsLeft) || CorruptionTaxFor(state, move) == QuestionTax)
+	}
+	for _, roomID := range GetAdjacentRooms(player.Location) {
+		if DoorBetween(state, player.Location, roomID).shut() {
+			add(OpenDoorAction{PlayerID: player.ID, To: roomID}, false)
+		}
+	}
+
+	played := make(map[CardID]bool) // Duplicate cards are one option
+	for _, cardID := range player.Hand {
+		if played[cardID] {
+			continue
+		}
+		played[cardID] = true
+
+		// Movement cards are one option per room they can reach
+		steps := SprintSteps(CardDB[cardID])
+		targets := SprintTargets(state, player.ID, steps)
+		if len(targets) == 0 {
+			add(PlayCardAction{PlayerID: player.ID, CardID: cardID}, false)
+		}
+		for _, target := range targets {
+			path := PlanSprint(state, player.ID, target, steps).Path
+			add(PlayCardAction{PlayerID: player.ID, CardID: cardID, Target: target}, questionsLeft && crossesUnexplored(state, path))
+		}
+	}
+
+	add(SearchAction{PlayerID: player.ID}, false)
+	add(ShootAction{PlayerID: player.ID}, false)
+	add(MeleeAction{PlayerID: player.ID}, false)
+	add(RoomAction{PlayerID: player.ID}, false)
+	add(PassAction{PlayerID: player.ID}, false)
+	return options
+}
+
+// crossesUnexplored reports whether a path enters an unexplored room after
+// its first
+func crossesUnexplored(state *GameState, path []RoomID) bool {
+	for _, id := range path[1:] {
+		if room := state.Rooms[id]; room != nil && !room.Explored {
+			return true
+		}
+	}
+	return false
+}
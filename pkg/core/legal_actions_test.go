@@ -0,0 +1,82 @@
+package core
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLegalActions(t *testing.T) {
+	withTestQuestions(t)
+	previousCards := CardDB
+	t.Cleanup(func() { CardDB = previousCards })
+	CardDB = map[CardID]Card{"C1": {ID: "C1", Name: "Test Card"}}
+
+	state := checkedTestState()
+	state.Players["P1"].Hand = []CardID{"C1", "C1"}
+	state.Rooms["R12"].Type = MedBay
+	state.QuestionOrder = []int{0}
+	adjacent := GetAdjacentRooms("R12")
+	state.Rooms[adjacent[0]].Explored = true
+	state.Enemies["E1"] = &Enemy{ID: "E1", Type: InfiniteLoop, HP: 3, MaxHP: 3, Location: adjacent[0]}
+
+	options := LegalActions(&state)
+
+	byAction := make(map[Action]ActionOption)
+	for _, option := range options {
+		if _, duplicate := byAction[option.Action]; duplicate {
+			t.Errorf("option %#v listed twice", option.Action)
+		}
+		byAction[option.Action] = option
+	}
+
+	if want := len(adjacent) + 6; len(options) != want {
+		t.Errorf("expected %d options, got %d", want, len(options))
+	}
+	for i, roomID := range adjacent {
+		option, exists := byAction[MoveAction{PlayerID: "P1", To: roomID}]
+		if !exists || !option.Legal() {
+			t.Errorf("expected a legal move to %s", roomID)
+		}
+		if option.NeedsQuestion != (i != 0) {
+			t.Errorf("move to %s: NeedsQuestion = %v", roomID, option.NeedsQuestion)
+		}
+	}
+	for _, action := range []Action{
+		PlayCardAction{PlayerID: "P1", CardID: "C1"},
+		SearchAction{PlayerID: "P1"},
+		ShootAction{PlayerID: "P1"},
+		RoomAction{PlayerID: "P1"},
+		PassAction{PlayerID: "P1"},
+	} {
+		if option := byAction[action]; !option.Legal() {
+			t.Errorf("expected %#v to be legal, got %q", action, option.Reason())
+		}
+	}
+
+	melee := byAction[MeleeAction{PlayerID: "P1"}]
+	if melee.Legal() || !errors.Is(melee.Err, ErrNoTargets) || melee.Reason() == "" {
+		t.Errorf("expected melee disabled for lack of targets, got %v", melee.Err)
+	}
+}
+
+func TestLegalActionsMatchApplyChecked(t *testing.T) {
+	withTestQuestions(t)
+	state := checkedTestState()
+	state.ActionsLeft = 0
+
+	for _, option := range LegalActions(&state) {
+		_, err := ApplyChecked(state, option.Action, NewEffectLog())
+		if (err == nil) != option.Legal() {
+			t.Errorf("%#v: LegalActions says legal=%v, ApplyChecked returned %v", option.Action, option.Legal(), err)
+		}
+	}
+}
+
+func TestLegalActionsWithoutActivePlayer(t *testing.T) {
+	withTestQuestions(t)
+	state := checkedTestState()
+	state.ActivePlayer = ""
+	if options := LegalActions(&state); options != nil {
+		t.Errorf("expected no options without an active player, got %d", len(options))
+	}
+}
@@ -0,0 +1,21 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+// TestMain loads the default map, which most tests play on, and the enemy
+// definitions every spawn needs
+func TestMain(m *testing.M) {
+	if err := LoadMap("../../data", DefaultMapName); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load the default map: %v\n", err)
+		os.Exit(1)
+	}
+	if err := LoadEnemies("../../data"); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load the enemies: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(m.Run())
+}
@@ -0,0 +1,272 @@
+package core
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// RandomMapName asks for a generated map seeded from the game seed
+const RandomMapName = "random"
+
+// Generated maps always have these many engine and escape rooms, like the
+// default map
+const (
+	GeneratedEngineRooms = 3
+	GeneratedEscapeRooms = 2
+)
+
+// MinGeneratedRooms leaves at least two rooms for the type pool after the
+// start, key, engine and escape rooms
+const MinGeneratedRooms = 1 + 1 + GeneratedEngineRooms + GeneratedEscapeRooms + 2
+
+// Grid limits for generated maps; wider grids no longer fit the terminal
+const (
+	MaxGeneratedRows = 12
+	MaxGeneratedCols = 8
+)
+
+// MapGenOptions sizes a generated map
+type MapGenOptions struct {
+	Rows, Cols int // Grid size
+	Rooms      int // Rooms placed on the grid
+}
+
+// DefaultMapGenOptions matches the size of the default map
+var DefaultMapGenOptions = MapGenOptions{Rows: 7, Cols: 6, Rooms: 20}
+
+// generatedPoolShares is the default map's type pool; generated maps deal
+// the same mix scaled to their number of rooms without a role
+var generatedPoolShares = []struct {
+	roomType RoomType
+	share    int
+}{
+	{AmmoCache, 3},
+	{MedBay, 3},
+	{CleanRoomType, 1},
+	{EnemySpawn, 4},
+	{Empty, 2},
+}
+
+// Validate checks the options describe a map that can be generated
+func (o MapGenOptions) Validate() error {
+	switch {
+	case o.Rows < 3 || o.Rows > MaxGeneratedRows:
+		return fmt.Errorf("rows must be between 3 and %d, got %d", MaxGeneratedRows, o.Rows)
+	case o.Cols < 3 || o.Cols > MaxGeneratedCols:
+		return fmt.Errorf("cols must be between 3 and %d, got %d", MaxGeneratedCols, o.Cols)
+	case o.Rooms < MinGeneratedRooms || o.Rooms > o.Rows*o.Cols:
+		return fmt.Errorf("rooms must be between %d and %d for a %d×%d grid, got %d",
+			MinGeneratedRooms, o.Rows*o.Cols, o.Rows, o.Cols, o.Rooms)
+	}
+	return nil
+}
+
+// GeneratedMapName names the map generated from a seed and options, e.g.
+// "random-7x6-20-42". The name is stored in GameState.Map, and LoadMap
+// regenerates the same map from it when a game is resumed or replayed.
+func GeneratedMapName(seed int64, opts MapGenOptions) string {
+	return fmt.Sprintf("%s-%dx%d-%d-%d", RandomMapName, opts.Rows, opts.Cols, opts.Rooms, seed)
+}
+
+// ParseGeneratedMapName reads the seed and options back from a generated
+// map's name
+func ParseGeneratedMapName(name string) (int64, MapGenOptions, bool) {
+	if !strings.HasPrefix(name, RandomMapName+"-") {
+		return 0, MapGenOptions{}, false
+	}
+	var seed int64
+	var opts MapGenOptions
+	if _, err := fmt.Sscanf(name, RandomMapName+"-%dx%d-%d-%d", &opts.Rows, &opts.Cols, &opts.Rooms, &seed); err != nil {
+		return 0, MapGenOptions{}, false
+	}
+	if GeneratedMapName(seed, opts) != name {
+		return 0, MapGenOptions{}, false // Trailing text or non-canonical numbers
+	}
+	return seed, opts, true
+}
+
+// GenerateMap builds a random connected map from a seed and makes it the
+// current map. The start room sits in the middle of the grid, the escape
+// rooms as far from it as the layout allows, the engines spread out between
+// them and the key about halfway out, all measured in steps with
+// CanTraverse. The same seed and options always give the same map.
+func GenerateMap(seed int64, opts MapGenOptions) (*ShipMap, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	rng := rand.New(rand.NewSource(seed))
+
+	shipMap := &ShipMap{
+		Name:        GeneratedMapName(seed, opts),
+		Description: fmt.Sprintf("A generated %d-room ship (seed %d)", opts.Rooms, seed),
+		Rows:        opts.Rows,
+		Cols:        opts.Cols,
+		Rooms:       make(map[RoomID]MapRoom, opts.Rooms),
+	}
+	cells := growLayout(rng, opts)
+
+	// Number rooms in reading order so IDs run left to right, top to bottom
+	startCell := cells[0]
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].Row != cells[j].Row {
+			return cells[i].Row < cells[j].Row
+		}
+		return cells[i].Col < cells[j].Col
+	})
+	for i, pos := range cells {
+		id := RoomID(fmt.Sprintf("R%02d", i+1))
+		shipMap.Rooms[id] = MapRoom{ID: id, Pos: pos}
+		if pos == startCell {
+			shipMap.Start = id
+		}
+	}
+
+	// Distances are walked on the new layout, so it has to be current
+	previous := CurrentMap
+	UseMap(shipMap)
+	if err := placeRoles(shipMap, rng); err != nil {
+		if previous != nil {
+			UseMap(previous)
+		}
+		return nil, err
+	}
+	return shipMap, nil
+}
+
+// growLayout grows a connected set of cells from the middle of the grid by
+// repeatedly adding a random free neighbour. The first cell is the start.
+func growLayout(rng *rand.Rand, opts MapGenOptions) []Coord {
+	start := Coord{Row: opts.Rows / 2, Col: opts.Cols / 2}
+	cells := []Coord{start}
+	taken := map[Coord]bool{start: true}
+
+	for len(cells) < opts.Rooms {
+		// Frontier in a fixed order so the seed alone decides the layout
+		var frontier []Coord
+		seen := make(map[Coord]bool)
+		for _, cell := range cells {
+			for _, d := range orthoDirs {
+				next := Coord{Row: cell.Row + d.Row, Col: cell.Col + d.Col}
+				if next.Row < 0 || next.Row >= opts.Rows || next.Col < 0 || next.Col >= opts.Cols {
+					continue
+				}
+				if !taken[next] && !seen[next] {
+					seen[next] = true
+					frontier = append(frontier, next)
+				}
+			}
+		}
+		next := frontier[rng.Intn(len(frontier))]
+		taken[next] = true
+		cells = append(cells, next)
+	}
+	return cells
+}
+
+// placeRoles assigns the escape, engine and key rooms around the start room
+// and deals the type pool to the rest
+func placeRoles(shipMap *ShipMap, rng *rand.Rand) error {
+	ids := shipMap.sortedRoomIDs()
+	fromStart := roomDistances(shipMap.Start, ids)
+	assigned := map[RoomID]bool{shipMap.Start: true}
+
+	// pick returns the free room with the highest score, breaking ties at random
+	pick := func(score func(RoomID) int) RoomID {
+		var best []RoomID
+		bestScore := 0
+		for _, id := range ids {
+			if assigned[id] {
+				continue
+			}
+			switch s := score(id); {
+			case len(best) == 0 || s > bestScore:
+				best, bestScore = []RoomID{id}, s
+			case s == bestScore:
+				best = append(best, id)
+			}
+		}
+		choice := best[rng.Intn(len(best))]
+		assigned[choice] = true
+		return choice
+	}
+
+	// Escapes: the farthest room, then the room farthest from both the start
+	// and the escapes already placed
+	placed := []map[RoomID]int{fromStart}
+	nearest := func(id RoomID) int {
+		closest := -1
+		for _, distances := range placed {
+			if d := distances[id]; closest < 0 || d < closest {
+				closest = d
+			}
+		}
+		return closest
+	}
+	for i := 0; i < GeneratedEscapeRooms; i++ {
+		escape := pick(nearest)
+		shipMap.Escapes = append(shipMap.Escapes, escape)
+		placed = append(placed, roomDistances(escape, ids))
+	}
+
+	// Engines: spread out, each as far as possible from every placed room
+	for i := 0; i < GeneratedEngineRooms; i++ {
+		engine := pick(nearest)
+		shipMap.Engines = append(shipMap.Engines, engine)
+		placed = append(placed, roomDistances(engine, ids))
+	}
+
+	// Key: about halfway between the start and the farthest escape
+	halfway := (fromStart[shipMap.Escapes[0]] + 1) / 2
+	shipMap.Key = pick(func(id RoomID) int {
+		d := fromStart[id] - halfway
+		if d < 0 {
+			d = -d
+		}
+		return -d
+	})
+
+	// Solvable: the key, every engine and an escape can be walked to
+	required := append([]RoomID{shipMap.Key}, shipMap.Engines...)
+	required = append(required, shipMap.Escapes...)
+	for _, id := range required {
+		if _, reachable := fromStart[id]; !reachable {
+			return fmt.Errorf("generated map %s: room %s cannot be reached from the start room", shipMap.Name, id)
+		}
+	}
+
+	// Type pool: the default mix scaled to the free rooms, with any rounding
+	// left over filled with empty rooms
+	free := len(ids) - len(assigned)
+	total := 0
+	for _, entry := range generatedPoolShares {
+		total += entry.share
+	}
+	for _, entry := range generatedPoolShares {
+		for n := 0; n < free*entry.share/total; n++ {
+			shipMap.TypePool = append(shipMap.TypePool, entry.roomType)
+		}
+	}
+	for len(shipMap.TypePool) < free {
+		shipMap.TypePool = append(shipMap.TypePool, Empty)
+	}
+	return nil
+}
+
+// roomDistances returns the number of steps from one room to every room it
+// can reach on the current map
+func roomDistances(from RoomID, rooms []RoomID) map[RoomID]int {
+	probe := &GameState{Rooms: make(map[RoomID]*RoomState, len(rooms))}
+	for _, id := range rooms {
+		probe.Rooms[id] = &RoomState{ID: id}
+	}
+
+	distances := make(map[RoomID]int, len(rooms))
+	for _, id := range rooms {
+		if path := CanTraverse(probe, PathQuery{From: from, To: id}); path.Valid {
+			distances[id] = len(path.Path) - 1
+		}
+	}
+	return distances
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+// withGeneratedMap restores the previous map after a test generates one
+func withGeneratedMap(t *testing.T) {
+	t.Helper()
+	previous := CurrentMap
+	t.Cleanup(func() { UseMap(previous) })
+}
+
+func TestGenerateMapIsSolvable(t *testing.T) {
+	withGeneratedMap(t)
+	sizes := []MapGenOptions{DefaultMapGenOptions, {Rows: 3, Cols: 3, Rooms: MinGeneratedRooms}, {Rows: 10, Cols: 8, Rooms: 45}}
+
+	for _, opts := range sizes {
+		for seed := int64(1); seed <= 40; seed++ {
+			shipMap, err := GenerateMap(seed, opts)
+			if err != nil {
+				t.Fatalf("%+v seed %d: %v", opts, seed, err)
+			}
+			if len(shipMap.Rooms) != opts.Rooms || len(ROOM_POSITIONS) != opts.Rooms {
+				t.Fatalf("%+v seed %d: expected %d rooms, got %d", opts, seed, opts.Rooms, len(shipMap.Rooms))
+			}
+			if unreachable := shipMap.unreachableRooms(); len(unreachable) > 0 {
+				t.Fatalf("%+v seed %d: rooms %v are not connected", opts, seed, unreachable)
+			}
+			if len(shipMap.Engines) != GeneratedEngineRooms || len(shipMap.Escapes) != GeneratedEscapeRooms {
+				t.Fatalf("%+v seed %d: expected %d engines and %d escapes, got %v and %v",
+					opts, seed, GeneratedEngineRooms, GeneratedEscapeRooms, shipMap.Engines, shipMap.Escapes)
+			}
+
+			// Every room has exactly one role or one pool type
+			roles := 0
+			for id := range shipMap.Rooms {
+				if shipMap.roleOf(id) != NoRole {
+					roles++
+				}
+			}
+			if roles != 2+GeneratedEngineRooms+GeneratedEscapeRooms || len(shipMap.TypePool) != opts.Rooms-roles {
+				t.Fatalf("%+v seed %d: %d roles and %d pool types for %d rooms", opts, seed, roles, len(shipMap.TypePool), opts.Rooms)
+			}
+
+			// No escape is closer to the start than any other room
+			fromStart := roomDistances(shipMap.Start, shipMap.sortedRoomIDs())
+			farthest := 0
+			for _, d := range fromStart {
+				if d > farthest {
+					farthest = d
+				}
+			}
+			if d := fromStart[shipMap.Escapes[0]]; d != farthest {
+				t.Fatalf("%+v seed %d: first escape is %d steps out, the farthest room %d", opts, seed, d, farthest)
+			}
+		}
+	}
+}
+
+func TestGenerateMapIsDeterministic(t *testing.T) {
+	withGeneratedMap(t)
+	first, err := GenerateMap(42, DefaultMapGenOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := GenerateMap(42, DefaultMapGenOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Error("expected the same seed to generate the same map")
+	}
+	if CurrentMap != second {
+		t.Error("expected the generated map to become the current map")
+	}
+
+	other, err := GenerateMap(43, DefaultMapGenOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(first.Rooms, other.Rooms) {
+		t.Error("expected another seed to generate another layout")
+	}
+}
+
+func TestGeneratedMapNames(t *testing.T) {
+	withGeneratedMap(t)
+	opts := MapGenOptions{Rows: 5, Cols: 4, Rooms: 12}
+	name := GeneratedMapName(-7, opts)
+	if name != "random-5x4-12--7" {
+		t.Errorf("unexpected generated map name %q", name)
+	}
+	if seed, parsed, ok := ParseGeneratedMapName(name); !ok || seed != -7 || parsed != opts {
+		t.Errorf("expected seed -7 and %+v back, got %d %+v %v", opts, seed, parsed, ok)
+	}
+	for _, invalid := range []string{"random", "random-5x4-12", "random-5x4-12-7x", "random-05x4-12-7", "default"} {
+		if _, _, ok := ParseGeneratedMapName(invalid); ok {
+			t.Errorf("expected %q not to be a generated map name", invalid)
+		}
+	}
+
+	// Resumed and replayed games load their generated map by name
+	if err := LoadMap(t.TempDir(), name); err != nil {
+		t.Fatalf("failed to regenerate %s: %v", name, err)
+	}
+	if CurrentMap.Name != name || GridRows != 5 || GridCols != 4 {
+		t.Errorf("expected %s on a 5×4 grid, got %s on %d×%d", name, CurrentMap.Name, GridRows, GridCols)
+	}
+	state := Apply(GameState{}, InitializeGameAction{Seed: 3, PlayerClasses: []DevClass{Backend}}, NewEffectLog())
+	if state.Map != name || len(state.Rooms) != 12 || state.Players["P1"].Location != StartRoom() {
+		t.Errorf("expected a 12-room game on %s starting in %s, got %q with %d rooms", name, StartRoom(), state.Map, len(state.Rooms))
+	}
+
+	if err := LoadMap(t.TempDir(), GeneratedMapName(1, MapGenOptions{Rows: 2, Cols: 2, Rooms: 4})); err == nil {
+		t.Error("expected a grid too small to generate to be rejected")
+	}
+	if CurrentMap.Name != name {
+		t.Error("expected a failed generation to keep the current map")
+	}
+}
@@ -0,0 +1,297 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YAMLMap represents the YAML structure of a map file
+type YAMLMap struct {
+	Description string          `yaml:"description"`
+	Grid        YAMLMapGrid     `yaml:"grid"`
+	Rooms       []YAMLMapRoom   `yaml:"rooms"`
+	Roles       YAMLMapRoles    `yaml:"roles"`
+	Pool        []YAMLPoolEntry `yaml:"pool"`
+	Walls       [][]string      `yaml:"walls"`
+	Doors       []YAMLMapDoor   `yaml:"doors"`
+}
+
+// YAMLMapGrid is the size of the grid rooms are placed on
+type YAMLMapGrid struct {
+	Rows int `yaml:"rows"`
+	Cols int `yaml:"cols"`
+}
+
+// YAMLMapRoom represents a room as stored in YAML
+type YAMLMapRoom struct {
+	ID   string `yaml:"id"`
+	Name string `yaml:"name,omitempty"`
+	Row  *int   `yaml:"row"`
+	Col  *int   `yaml:"col"`
+}
+
+// YAMLMapRoles assigns the fixed roles to rooms
+type YAMLMapRoles struct {
+	Start   string   `yaml:"start"`
+	Key     string   `yaml:"key"`
+	Engines []string `yaml:"engines"`
+	Escapes []string `yaml:"escapes"`
+}
+
+// YAMLPoolEntry is a number of rooms of one type in the random type pool
+type YAMLPoolEntry struct {
+	Type  string `yaml:"type"`
+	Count int    `yaml:"count"`
+}
+
+// YAMLMapDoor is a door that does not start open
+type YAMLMapDoor struct {
+	Between []string `yaml:"between"`
+	State   string   `yaml:"state"`
+}
+
+// LoadMap loads data/maps/<name>.yaml and makes it the current map. Names
+// made by GeneratedMapName regenerate that map instead.
+func LoadMap(dataPath, name string) error {
+	if name == "" {
+		name = DefaultMapName
+	}
+	if seed, opts, generated := ParseGeneratedMapName(name); generated {
+		if _, err := GenerateMap(seed, opts); err != nil {
+			return fmt.Errorf("invalid map %s: %w", name, err)
+		}
+		return nil
+	}
+	if name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("invalid map name %q", name)
+	}
+
+	mapFilePath := filepath.Join(dataPath, "maps", name+".yaml")
+	data, err := ioutil.ReadFile(mapFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read map file: %w", err)
+	}
+
+	var yamlMap YAMLMap
+	if err := yaml.Unmarshal(data, &yamlMap); err != nil {
+		return fmt.Errorf("failed to parse map YAML %s: %w", filepath.Base(mapFilePath), err)
+	}
+
+	shipMap, err := convertYAMLToMap(name, yamlMap)
+	if err != nil {
+		return fmt.Errorf("invalid map %s: %w", filepath.Base(mapFilePath), err)
+	}
+
+	UseMap(shipMap)
+	return nil
+}
+
+// convertYAMLToMap converts YAML map format to a validated ShipMap
+func convertYAMLToMap(name string, yamlMap YAMLMap) (*ShipMap, error) {
+	shipMap := &ShipMap{
+		Name:        name,
+		Description: strings.TrimSpace(yamlMap.Description),
+		Rows:        yamlMap.Grid.Rows,
+		Cols:        yamlMap.Grid.Cols,
+		Rooms:       make(map[RoomID]MapRoom),
+	}
+	if shipMap.Rows <= 0 || shipMap.Cols <= 0 {
+		return nil, fmt.Errorf("grid must have positive rows and cols, got %d×%d", shipMap.Rows, shipMap.Cols)
+	}
+
+	// Rooms: unique IDs on unique cells inside the grid
+	occupied := make(map[Coord]RoomID)
+	for i, yamlRoom := range yamlMap.Rooms {
+		id := RoomID(strings.TrimSpace(yamlRoom.ID))
+		if id == "" {
+			return nil, fmt.Errorf("room #%d: missing id", i+1)
+		}
+		if _, duplicate := shipMap.Rooms[id]; duplicate {
+			return nil, fmt.Errorf("duplicate room id %s", id)
+		}
+		if yamlRoom.Row == nil || yamlRoom.Col == nil {
+			return nil, fmt.Errorf("room %s: missing row or col", id)
+		}
+		pos := Coord{Row: *yamlRoom.Row, Col: *yamlRoom.Col}
+		if pos.Row < 0 || pos.Row >= shipMap.Rows || pos.Col < 0 || pos.Col >= shipMap.Cols {
+			return nil, fmt.Errorf("room %s: position %d,%d is outside the %d×%d grid", id, pos.Row, pos.Col, shipMap.Rows, shipMap.Cols)
+		}
+		if other, taken := occupied[pos]; taken {
+			return nil, fmt.Errorf("rooms %s and %s share position %d,%d", other, id, pos.Row, pos.Col)
+		}
+		occupied[pos] = id
+		shipMap.Rooms[id] = MapRoom{ID: id, Name: strings.TrimSpace(yamlRoom.Name), Pos: pos}
+	}
+	if len(shipMap.Rooms) == 0 {
+		return nil, fmt.Errorf("no rooms defined")
+	}
+
+	// Roles: one start and key room, at least one engine and escape room,
+	// and no room with two roles
+	assigned := make(map[RoomID]string)
+	assign := func(role, value string) (RoomID, error) {
+		id := RoomID(strings.TrimSpace(value))
+		if id == "" {
+			return "", fmt.Errorf("missing %s room", role)
+		}
+		if _, exists := shipMap.Rooms[id]; !exists {
+			return "", fmt.Errorf("%s room %s is not on the map", role, id)
+		}
+		if other, taken := assigned[id]; taken {
+			return "", fmt.Errorf("room %s cannot be both %s and %s", id, other, role)
+		}
+		assigned[id] = role
+		return id, nil
+	}
+	var err error
+	if shipMap.Start, err = assign("start", yamlMap.Roles.Start); err != nil {
+		return nil, err
+	}
+	if shipMap.Key, err = assign("key", yamlMap.Roles.Key); err != nil {
+		return nil, err
+	}
+	roleLists := []struct {
+		role   string
+		values []string
+		rooms  *[]RoomID
+	}{
+		{"engine", yamlMap.Roles.Engines, &shipMap.Engines},
+		{"escape", yamlMap.Roles.Escapes, &shipMap.Escapes},
+	}
+	for _, list := range roleLists {
+		if len(list.values) == 0 {
+			return nil, fmt.Errorf("missing %s rooms", list.role)
+		}
+		for _, value := range list.values {
+			id, err := assign(list.role, value)
+			if err != nil {
+				return nil, err
+			}
+			*list.rooms = append(*list.rooms, id)
+		}
+	}
+
+	// Pool: exactly one type for every room without a role, kept in file
+	// order so the shuffle is the same for a given seed
+	for i, entry := range yamlMap.Pool {
+		roomType, err := stringToRoomType(entry.Type)
+		if err != nil {
+			return nil, fmt.Errorf("pool entry %d: %w", i+1, err)
+		}
+		if entry.Count <= 0 {
+			return nil, fmt.Errorf("pool entry %d: count must be positive, got %d", i+1, entry.Count)
+		}
+		for n := 0; n < entry.Count; n++ {
+			shipMap.TypePool = append(shipMap.TypePool, roomType)
+		}
+	}
+	if free := len(shipMap.Rooms) - len(assigned); len(shipMap.TypePool) != free {
+		return nil, fmt.Errorf("pool holds %d room types but %d rooms have no role", len(shipMap.TypePool), free)
+	}
+
+	// Walls and doors: each on a passage between two neighbouring rooms,
+	// at most one per passage
+	passage := func(what string, pair []string) (Edge, error) {
+		if len(pair) != 2 {
+			return "", fmt.Errorf("%s %v: needs exactly two rooms", what, pair)
+		}
+		a, b := RoomID(strings.TrimSpace(pair[0])), RoomID(strings.TrimSpace(pair[1]))
+		roomA, existsA := shipMap.Rooms[a]
+		roomB, existsB := shipMap.Rooms[b]
+		if !existsA || !existsB {
+			return "", fmt.Errorf("%s %s–%s: room is not on the map", what, a, b)
+		}
+		rowGap, colGap := roomA.Pos.Row-roomB.Pos.Row, roomA.Pos.Col-roomB.Pos.Col
+		if rowGap*rowGap+colGap*colGap != 1 {
+			return "", fmt.Errorf("%s %s–%s: rooms are not neighbours", what, a, b)
+		}
+		edge := EdgeBetween(a, b)
+		if shipMap.Walls[edge] || shipMap.Doors[edge] != DoorOpen {
+			return "", fmt.Errorf("%s %s: passage already has a wall or door", what, edge)
+		}
+		return edge, nil
+	}
+	for _, pair := range yamlMap.Walls {
+		edge, err := passage("wall", pair)
+		if err != nil {
+			return nil, err
+		}
+		if shipMap.Walls == nil {
+			shipMap.Walls = make(map[Edge]bool)
+		}
+		shipMap.Walls[edge] = true
+	}
+	for _, yamlDoor := range yamlMap.Doors {
+		edge, err := passage("door", yamlDoor.Between)
+		if err != nil {
+			return nil, err
+		}
+		door, err := stringToDoorState(yamlDoor.State)
+		if err != nil {
+			return nil, fmt.Errorf("door %s: %w", edge, err)
+		}
+		if shipMap.Doors == nil {
+			shipMap.Doors = make(map[Edge]DoorState)
+		}
+		shipMap.Doors[edge] = door
+	}
+
+	if unreachable := shipMap.unreachableRooms(); len(unreachable) > 0 {
+		return nil, fmt.Errorf("rooms not connected to the start room: %v", unreachable)
+	}
+	return shipMap, nil
+}
+
+// unreachableRooms returns the rooms that cannot be walked to from the start
+// room through orthogonally adjacent rooms not walled off, in ID order
+func (m *ShipMap) unreachableRooms() []RoomID {
+	byPos := make(map[Coord]RoomID, len(m.Rooms))
+	for id, room := range m.Rooms {
+		byPos[room.Pos] = id
+	}
+
+	visited := map[RoomID]bool{m.Start: true}
+	queue := []RoomID{m.Start}
+	for len(queue) > 0 {
+		current := queue[0]
+		pos := m.Rooms[current].Pos
+		queue = queue[1:]
+		for _, d := range orthoDirs {
+			neighbor, exists := byPos[Coord{pos.Row + d.Row, pos.Col + d.Col}]
+			if exists && !visited[neighbor] && !m.Walls[EdgeBetween(current, neighbor)] {
+				visited[neighbor] = true
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	var unreachable []RoomID
+	for _, id := range m.sortedRoomIDs() {
+		if !visited[id] {
+			unreachable = append(unreachable, id)
+		}
+	}
+	return unreachable
+}
+
+// stringToRoomType converts a pool type name to RoomType
+func stringToRoomType(s string) (RoomType, error) {
+	switch s {
+	case "AmmoCache":
+		return AmmoCache, nil
+	case "MedBay":
+		return MedBay, nil
+	case "CleanRoom":
+		return CleanRoomType, nil
+	case "EnemySpawn":
+		return EnemySpawn, nil
+	case "Empty":
+		return Empty, nil
+	default:
+		return 0, fmt.Errorf("unknown room type: %s", s)
+	}
+}
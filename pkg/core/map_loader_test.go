@@ -0,0 +1,134 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// withTestMap installs a map from YAML for one test
+func withTestMap(t *testing.T, content string) {
+	t.Helper()
+	previous := CurrentMap
+	t.Cleanup(func() { UseMap(previous) })
+
+	dir := t.TempDir()
+	writeMapFile(t, dir, "test", content)
+	if err := LoadMap(dir, "test"); err != nil {
+		t.Fatalf("failed to load test map: %v", err)
+	}
+}
+
+func writeMapFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(dir, "maps"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "maps", name+".yaml"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// corridorMap is a 1×5 corridor: escape, key, start, engine, spare room
+const corridorMap = `
+grid: {rows: 1, cols: 5}
+rooms:
+  - {id: A, row: 0, col: 0}
+  - {id: B, name: Vault, row: 0, col: 1}
+  - {id: C, row: 0, col: 2}
+  - {id: D, row: 0, col: 3}
+  - {id: E, row: 0, col: 4}
+roles:
+  start: C
+  key: B
+  engines: [D]
+  escapes: [A]
+pool:
+  - {type: MedBay, count: 1}
+`
+
+func TestDefaultMapLayout(t *testing.T) {
+	if CurrentMap.Name != DefaultMapName || len(ROOM_POSITIONS) != 20 {
+		t.Fatalf("expected the 20-room default map, got %s with %d rooms", CurrentMap.Name, len(ROOM_POSITIONS))
+	}
+	if GridRows != 7 || GridCols != 6 {
+		t.Errorf("expected a 7×6 grid, got %d×%d", GridRows, GridCols)
+	}
+	if got, want := GetAdjacentRooms("R12"), []RoomID{"R07", "R11", "R13", "R16"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected R12 next to %v, got %v", want, got)
+	}
+	if StartRoom() != "R12" || RoleOf("R01") != KeyRole || EngineNumber("R18") != 2 || RoleOf("R07") != NoRole {
+		t.Error("unexpected roles on the default map")
+	}
+	if got := EscapeRooms(); !reflect.DeepEqual(got, []RoomID{"R19", "R20"}) {
+		t.Errorf("expected escape rooms R19 and R20, got %v", got)
+	}
+	if !IsHighValueRoom("R15") || IsHighValueRoom("R19") {
+		t.Error("expected engine rooms, not escape rooms, to be high value")
+	}
+}
+
+func TestGameOnCustomMap(t *testing.T) {
+	withTestMap(t, corridorMap)
+	state := Apply(GameState{}, InitializeGameAction{Seed: 1, PlayerClasses: []DevClass{Backend}}, NewEffectLog())
+
+	if state.Map != "test" || len(state.Rooms) != 5 {
+		t.Fatalf("expected the 5-room test map, got %q with %d rooms", state.Map, len(state.Rooms))
+	}
+	if state.Players["P1"].Location != "C" {
+		t.Errorf("expected P1 to start in C, got %s", state.Players["P1"].Location)
+	}
+	if room := state.Rooms["E"]; room.Type != MedBay || room.Explored {
+		t.Errorf("expected E to be an unexplored med bay from the pool, got %+v", room)
+	}
+	if room := state.Rooms["A"]; room.Type != Predefined || !room.Explored {
+		t.Errorf("expected the escape room to be predefined and explored, got %+v", room)
+	}
+	if GridRows != 1 || GridCols != 5 || RoomName("B") != "Vault" || RoomName("A") != "A" {
+		t.Error("expected the grid size and room names of the test map")
+	}
+	if got := GetAdjacentRooms("C"); !reflect.DeepEqual(got, []RoomID{"B", "D"}) {
+		t.Errorf("expected C next to B and D, got %v", got)
+	}
+}
+
+func TestLoadMapValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		replace [2]string
+		want    string
+	}{
+		{"duplicate room", [2]string{"{id: E,", "{id: D,"}, "duplicate room id D"},
+		{"outside grid", [2]string{"row: 0, col: 4}", "row: 1, col: 4}"}, "outside the 1×5 grid"},
+		{"shared cell", [2]string{"row: 0, col: 4}", "row: 0, col: 3}"}, "share position"},
+		{"missing role room", [2]string{"key: B", "key: Z"}, "key room Z is not on the map"},
+		{"two roles", [2]string{"escapes: [A]", "escapes: [D]"}, "both engine and escape"},
+		{"no escapes", [2]string{"escapes: [A]", "escapes: []"}, "missing escape rooms"},
+		{"pool too small", [2]string{"count: 1", "count: 2"}, "pool holds 2 room types but 1 rooms"},
+		{"unknown type", [2]string{"MedBay", "Kitchen"}, "unknown room type"},
+		{"disconnected", [2]string{"grid: {rows: 1, cols: 5}", "grid: {rows: 2, cols: 5}"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := strings.Replace(corridorMap, tt.replace[0], tt.replace[1], 1)
+			if tt.name == "disconnected" {
+				content = strings.Replace(content, "{id: E, row: 0, col: 4}", "{id: E, row: 1, col: 4}", 1)
+				tt.want = "not connected to the start room: [E]"
+			}
+			dir := t.TempDir()
+			writeMapFile(t, dir, "bad", content)
+			if err := LoadMap(dir, "bad"); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected an error mentioning %q, got %v", tt.want, err)
+			}
+		})
+	}
+
+	if err := LoadMap("../../data", "../cards"); err == nil {
+		t.Error("expected map names with a path to be rejected")
+	}
+	if CurrentMap.Name != DefaultMapName {
+		t.Errorf("expected failed loads to keep the current map, got %s", CurrentMap.Name)
+	}
+}
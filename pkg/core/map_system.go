@@ -0,0 +1,159 @@
+package core
+
+import "sort"
+
+// DefaultMapName is the map new games use unless another one is chosen
+const DefaultMapName = "default"
+
+// RoomRole is the fixed part a room plays on a map. Rooms with a role are
+// explored from the start; the others are dealt a type from the map's pool.
+type RoomRole string
+
+const (
+	NoRole     RoomRole = ""
+	StartRole  RoomRole = "start"  // Every developer starts here
+	KeyRole    RoomRole = "key"    // Searching grants the BOOT.dev KEY
+	EngineRole RoomRole = "engine" // Searching grants the engine cards
+	EscapeRole RoomRole = "escape" // The engine is activated here to win
+)
+
+// ShipMap is a board layout, loaded from data/maps/<name>.yaml
+type ShipMap struct {
+	Name        string // File name without .yaml; stored in GameState.Map
+	Description string
+	Rows, Cols  int
+	Rooms       map[RoomID]MapRoom
+	Start       RoomID
+	Key         RoomID
+	Engines     []RoomID // In label order: EN1, EN2, ...
+	Escapes     []RoomID
+	TypePool    []RoomType         // Types shuffled onto the rooms without a role
+	Walls       map[Edge]bool      // Neighbouring rooms with no passage between them
+	Doors       map[Edge]DoorState // Doors that start shut or destroyed; the rest start open
+}
+
+// MapRoom is one room of a map
+type MapRoom struct {
+	ID   RoomID
+	Name string
+	Pos  Coord
+}
+
+// CurrentMap is the layout games are played on, set by LoadMap or UseMap
+var CurrentMap *ShipMap
+
+// ROOM_POSITIONS places every room of CurrentMap on its grid
+var ROOM_POSITIONS = map[string]Coord{}
+
+// Grid size of CurrentMap
+var (
+	GridRows int
+	GridCols int
+)
+
+// UseMap makes m the current map and rebuilds the room positions and
+// adjacency derived from it
+func UseMap(m *ShipMap) {
+	positions := make(map[string]Coord, len(m.Rooms))
+	for id, room := range m.Rooms {
+		positions[string(id)] = room.Pos
+	}
+
+	CurrentMap = m
+	ROOM_POSITIONS = positions
+	GridRows, GridCols = m.Rows, m.Cols
+	adjacencyMap = buildAdjacency()
+}
+
+// RoleOf returns the fixed role of a room on the current map
+func RoleOf(roomID RoomID) RoomRole {
+	if CurrentMap == nil {
+		return NoRole
+	}
+	return CurrentMap.roleOf(roomID)
+}
+
+func (m *ShipMap) roleOf(roomID RoomID) RoomRole {
+	switch {
+	case roomID == m.Start:
+		return StartRole
+	case roomID == m.Key:
+		return KeyRole
+	case containsRoom(m.Engines, roomID):
+		return EngineRole
+	case containsRoom(m.Escapes, roomID):
+		return EscapeRole
+	}
+	return NoRole
+}
+
+// StartRoom returns the room every developer starts in
+func StartRoom() RoomID {
+	if CurrentMap == nil {
+		return ""
+	}
+	return CurrentMap.Start
+}
+
+// EscapeRooms returns the rooms where the engine can be activated
+func EscapeRooms() []RoomID {
+	if CurrentMap == nil {
+		return nil
+	}
+	return append([]RoomID(nil), CurrentMap.Escapes...)
+}
+
+// EngineNumber returns 1 for the map's first engine room, 2 for the second
+// and so on, or 0 when the room is not an engine room
+func EngineNumber(roomID RoomID) int {
+	if CurrentMap == nil {
+		return 0
+	}
+	for i, engine := range CurrentMap.Engines {
+		if engine == roomID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// IsHighValueRoom reports whether entering a room asks harder questions:
+// the key room and the engine rooms
+func IsHighValueRoom(roomID RoomID) bool {
+	return RoleOf(roomID).highValue()
+}
+
+// highValue reports whether rooms with the role start unexplored and ask
+// harder questions
+func (r RoomRole) highValue() bool {
+	return r == KeyRole || r == EngineRole
+}
+
+// RoomName returns the map's name for a room, or its ID when it has none
+func RoomName(roomID RoomID) string {
+	if CurrentMap != nil {
+		if room, exists := CurrentMap.Rooms[roomID]; exists && room.Name != "" {
+			return room.Name
+		}
+	}
+	return string(roomID)
+}
+
+// sortedRoomIDs returns the map's room IDs in ascending order
+func (m *ShipMap) sortedRoomIDs() []RoomID {
+	ids := make([]RoomID, 0, len(m.Rooms))
+	for id := range m.Rooms {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func containsRoom(rooms []RoomID, roomID RoomID) bool {
+	for _, room := range rooms {
+		if room == roomID {
+			return true
+		}
+	}
+	return false
+}
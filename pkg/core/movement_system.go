@@ -14,13 +14,16 @@ var (
 	}
 )
 
-// Pre-computed adjacency map for performance
-var adjacencyMap = buildAdjacency()
+// Pre-computed adjacency map for performance, rebuilt by UseMap
+var adjacencyMap map[RoomID][]RoomID
 
 type PathQuery struct {
 	From, To RoomID
 	MaxSteps int  // 1 = current behavior, 0 = unlimited
 	Diagonal bool // false for Devesis (orthogonal only)
+	OpenOnly bool // true = only through open or destroyed doors (developers, and enemies avoiding shut ones)
+	// true = around corrupted rooms; the destination itself may be corrupted
+	AvoidCorrupted bool
 }
 
 type PathResult struct {
@@ -63,7 +66,13 @@ func CanTraverse(gs *GameState, q PathQuery) PathResult {
 			if gs.Rooms[neighbor] == nil {
 				continue
 			}
-			// Note: Corrupted rooms are passable (blocking not implemented for solo play)
+			// Corrupted rooms are passable but tax movement (see CorruptionTaxFor)
+			if q.AvoidCorrupted && neighbor != q.To && gs.Rooms[neighbor].Corrupted {
+				continue
+			}
+			if q.OpenOnly && DoorBetween(gs, current.room, neighbor).shut() {
+				continue
+			}
 			
 			if neighbor == q.To {
 				return PathResult{
@@ -85,10 +94,11 @@ func CanTraverse(gs *GameState, q PathQuery) PathResult {
 	return PathResult{Valid: false, Path: nil}
 }
 
-// CanMove maintains backward compatibility (1-step movement)
+// CanMove reports whether a developer can step into a neighbouring room;
+// shut doors are in the way until opened
 func CanMove(gs *GameState, from, to RoomID) bool {
 	return CanTraverse(gs, PathQuery{
-		From: from, To: to, MaxSteps: 1,
+		From: from, To: to, MaxSteps: 1, OpenOnly: true,
 	}).Valid
 }
 
@@ -112,11 +122,18 @@ func computeNeighbors(pos Coord, dirs []Coord) []RoomID {
 	return out
 }
 
-// buildAdjacency pre-computes all orthogonal adjacencies
+// buildAdjacency pre-computes all orthogonal adjacencies, leaving out the
+// passages the current map walls off
 func buildAdjacency() map[RoomID][]RoomID {
 	adj := make(map[RoomID][]RoomID, len(ROOM_POSITIONS))
 	for id, pos := range ROOM_POSITIONS {
-		adj[RoomID(id)] = computeNeighbors(pos, orthoDirs)
+		var neighbors []RoomID
+		for _, neighbor := range computeNeighbors(pos, orthoDirs) {
+			if CurrentMap == nil || !CurrentMap.Walls[EdgeBetween(RoomID(id), neighbor)] {
+				neighbors = append(neighbors, neighbor)
+			}
+		}
+		adj[RoomID(id)] = neighbors
 	}
 	return adj
 }
@@ -148,4 +165,46 @@ func GetDiagonalNeighbors(roomID RoomID) []RoomID {
 		return nil
 	}
 	return computeNeighbors(pos, diagDirs)
-}
\ No newline at end of file
+}
+
+// CorruptionTax is what entering a corrupted room costs on top of the move
+type CorruptionTax int
+
+const (
+	NoTax          CorruptionTax = iota
+	ExtraActionTax               // The move spends one more action
+	QuestionTax                  // A coding question at the door, even for an explored room
+)
+
+// CorruptionTaxFor returns what a move costs for entering a corrupted room.
+// A developer with a spare action pays it; on their last action they must
+// answer a question instead, and a wrong answer keeps them out. Unexplored
+// rooms already ask a question, and once the bank is exhausted the way is free.
+func CorruptionTaxFor(state *GameState, move MoveAction) CorruptionTax {
+	room := state.Rooms[move.To]
+	if room == nil || !room.Corrupted {
+		return NoTax
+	}
+	if state.ActionsLeft > 1 {
+		return ExtraActionTax
+	}
+	if !room.Explored || PeekQuestion(state).ID == -1 {
+		return NoTax
+	}
+	return QuestionTax
+}
+
+// ActionCost returns how many actions an action spends from ActionsLeft
+func ActionCost(state *GameState, action Action) int {
+	switch a := action.(type) {
+	case MoveAction:
+		if CorruptionTaxFor(state, a) == ExtraActionTax {
+			return 2
+		}
+		return 1
+	case SearchAction, ShootAction, MeleeAction, PlayCardAction, RoomAction, OpenDoorAction:
+		return 1
+	default:
+		return 0 // Answers, skips, passes and setup actions are free
+	}
+}
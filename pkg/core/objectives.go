@@ -0,0 +1,288 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ObjectiveScope separates personal goals from corporate (bonus) goals
+type ObjectiveScope int
+
+const (
+	PersonalObjective ObjectiveScope = iota
+	CorporateObjective
+)
+
+// ObjectiveKind is the rule used to evaluate an objective at end of game
+type ObjectiveKind string
+
+const (
+	ObjKillEnemies       ObjectiveKind = "kill_enemies"
+	ObjTeamKills         ObjectiveKind = "team_kills"
+	ObjEscapeWithHP      ObjectiveKind = "escape_with_hp"
+	ObjExploreRooms      ObjectiveKind = "explore_rooms"
+	ObjMaxCorruptedRooms ObjectiveKind = "max_corrupted_rooms"
+	ObjAllSurvive        ObjectiveKind = "all_survive"
+)
+
+// Objective is a single personal or corporate goal
+type Objective struct {
+	ID          ObjectiveID
+	Name        string
+	Description string
+	Scope       ObjectiveScope
+	Kind        ObjectiveKind
+	Enemy       *EnemyType // Only for kill_enemies; nil = any enemy
+	N           int
+}
+
+// ObjectiveDatabase represents the YAML structure
+type ObjectiveDatabase struct {
+	Objectives struct {
+		Personal  []YAMLObjective `yaml:"personal"`
+		Corporate []YAMLObjective `yaml:"corporate"`
+	} `yaml:"objectives"`
+}
+
+// YAMLObjective represents an objective as stored in YAML
+type YAMLObjective struct {
+	ID    string `yaml:"id"`
+	Name  string `yaml:"name"`
+	Desc  string `yaml:"desc"`
+	Kind  string `yaml:"kind"`
+	Enemy string `yaml:"enemy,omitempty"`
+	N     int    `yaml:"n"`
+}
+
+var ObjectiveDB map[ObjectiveID]Objective
+
+// LoadObjectives loads the objective catalogue from YAML file
+func LoadObjectives(dataPath string) error {
+	objectiveFilePath := filepath.Join(dataPath, "objectives.yaml")
+
+	data, err := ioutil.ReadFile(objectiveFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read objectives file: %w", err)
+	}
+
+	var db ObjectiveDatabase
+	if err := yaml.Unmarshal(data, &db); err != nil {
+		return fmt.Errorf("failed to parse objectives YAML: %w", err)
+	}
+
+	objectives := make(map[ObjectiveID]Objective)
+	scopes := []struct {
+		scope ObjectiveScope
+		list  []YAMLObjective
+	}{
+		{PersonalObjective, db.Objectives.Personal},
+		{CorporateObjective, db.Objectives.Corporate},
+	}
+	for _, group := range scopes {
+		for _, yamlObj := range group.list {
+			objective, err := convertYAMLToObjective(yamlObj, group.scope)
+			if err != nil {
+				return fmt.Errorf("failed to convert objective %s: %w", yamlObj.ID, err)
+			}
+			if _, duplicate := objectives[objective.ID]; duplicate {
+				return fmt.Errorf("duplicate objective id: %s", objective.ID)
+			}
+			objectives[objective.ID] = objective
+		}
+	}
+
+	ObjectiveDB = objectives
+	return nil
+}
+
+// convertYAMLToObjective converts YAML objective format to core.Objective
+func convertYAMLToObjective(yamlObj YAMLObjective, scope ObjectiveScope) (Objective, error) {
+	if yamlObj.ID == "" {
+		return Objective{}, fmt.Errorf("missing id")
+	}
+
+	objective := Objective{
+		ID:          ObjectiveID(yamlObj.ID),
+		Name:        yamlObj.Name,
+		Description: yamlObj.Desc,
+		Scope:       scope,
+		Kind:        ObjectiveKind(yamlObj.Kind),
+		N:           yamlObj.N,
+	}
+
+	switch objective.Kind {
+	case ObjKillEnemies, ObjTeamKills, ObjEscapeWithHP, ObjExploreRooms:
+		if objective.N < 1 {
+			return Objective{}, fmt.Errorf("kind %s requires n >= 1", objective.Kind)
+		}
+	case ObjMaxCorruptedRooms:
+		if objective.N < 0 {
+			return Objective{}, fmt.Errorf("kind %s requires n >= 0", objective.Kind)
+		}
+	case ObjAllSurvive:
+	default:
+		return Objective{}, fmt.Errorf("unknown objective kind: %s", yamlObj.Kind)
+	}
+
+	if yamlObj.Enemy != "" {
+		if objective.Kind != ObjKillEnemies {
+			return Objective{}, fmt.Errorf("enemy only allowed for %s", ObjKillEnemies)
+		}
+		enemyType, err := stringToEnemyType(yamlObj.Enemy)
+		if err != nil {
+			return Objective{}, err
+		}
+		objective.Enemy = &enemyType
+	}
+
+	return objective, nil
+}
+
+// GetObjective retrieves an objective by ID
+func GetObjective(objectiveID ObjectiveID) (Objective, bool) {
+	objective, exists := ObjectiveDB[objectiveID]
+	return objective, exists
+}
+
+// dealObjectives gives each player one personal and one corporate objective.
+// Personal objectives are unique per player while the catalogue lasts.
+func dealObjectives(state *GameState, rng *rand.Rand) {
+	personal := objectiveIDsByScope(PersonalObjective)
+	corporate := objectiveIDsByScope(CorporateObjective)
+
+	rng.Shuffle(len(personal), func(i, j int) { personal[i], personal[j] = personal[j], personal[i] })
+	rng.Shuffle(len(corporate), func(i, j int) { corporate[i], corporate[j] = corporate[j], corporate[i] })
+
+	for i, playerID := range seatOrder(state) {
+		player := state.Players[playerID]
+		if len(personal) > 0 {
+			player.PersonalObj = personal[i%len(personal)]
+		}
+		if len(corporate) > 0 {
+			player.CorporateObj = corporate[i%len(corporate)]
+		}
+	}
+}
+
+// objectiveIDsByScope returns catalogue IDs for one scope in sorted order
+func objectiveIDsByScope(scope ObjectiveScope) []ObjectiveID {
+	ids := make([]ObjectiveID, 0)
+	for id, objective := range ObjectiveDB {
+		if objective.Scope == scope {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// ObjectiveResult is the evaluated state of one player's objective
+type ObjectiveResult struct {
+	Objective Objective
+	Complete  bool
+	Progress  string // Short human-readable progress, e.g. "2/3"
+}
+
+// PlayerOutcome summarises how a single developer finished the game
+type PlayerOutcome struct {
+	PlayerID  PlayerID
+	Escaped   bool
+	Personal  *ObjectiveResult
+	Corporate *ObjectiveResult
+	Victory   bool // Escaped alive with personal objective complete
+}
+
+// EvaluateObjective checks one objective against the state for a player.
+// teamWin reports whether the team escaped; it is required for escape objectives.
+func EvaluateObjective(state *GameState, player *PlayerState, objective Objective, teamWin bool) ObjectiveResult {
+	result := ObjectiveResult{Objective: objective}
+
+	switch objective.Kind {
+	case ObjKillEnemies:
+		kills := 0
+		for enemyType, count := range player.Kills {
+			if objective.Enemy == nil || *objective.Enemy == enemyType {
+				kills += count
+			}
+		}
+		result.Complete = kills >= objective.N
+		result.Progress = fmt.Sprintf("%d/%d", kills, objective.N)
+	case ObjTeamKills:
+		kills := 0
+		for _, teammate := range state.Players {
+			for _, count := range teammate.Kills {
+				kills += count
+			}
+		}
+		result.Complete = kills >= objective.N
+		result.Progress = fmt.Sprintf("%d/%d", kills, objective.N)
+	case ObjEscapeWithHP:
+		result.Complete = teamWin && int(player.HP) >= objective.N
+		result.Progress = fmt.Sprintf("HP %d/%d", player.HP, objective.N)
+	case ObjExploreRooms:
+		explored := 0
+		for _, room := range state.Rooms {
+			if room.Explored {
+				explored++
+			}
+		}
+		result.Complete = explored >= objective.N
+		result.Progress = fmt.Sprintf("%d/%d", explored, objective.N)
+	case ObjMaxCorruptedRooms:
+		corrupted := 0
+		for _, room := range state.Rooms {
+			if room.Corrupted {
+				corrupted++
+			}
+		}
+		result.Complete = corrupted <= objective.N
+		result.Progress = fmt.Sprintf("%d corrupted (max %d)", corrupted, objective.N)
+	case ObjAllSurvive:
+		alive := len(TurnOrder(state))
+		result.Complete = alive == len(state.Players)
+		result.Progress = fmt.Sprintf("%d/%d alive", alive, len(state.Players))
+	}
+
+	return result
+}
+
+// EvaluateOutcomes scores every player's objectives at the end of the game.
+// A developer only achieves victory if the team escaped, they are alive,
+// and their personal objective is complete.
+func EvaluateOutcomes(state *GameState, teamWin bool) []PlayerOutcome {
+	outcomes := make([]PlayerOutcome, 0, len(state.Players))
+	for _, playerID := range seatOrder(state) {
+		player := state.Players[playerID]
+		outcome := PlayerOutcome{
+			PlayerID: playerID,
+			Escaped:  teamWin && player.HP > 0,
+		}
+
+		if objective, exists := GetObjective(player.PersonalObj); exists {
+			result := EvaluateObjective(state, player, objective, teamWin)
+			outcome.Personal = &result
+		}
+		if objective, exists := GetObjective(player.CorporateObj); exists {
+			result := EvaluateObjective(state, player, objective, teamWin)
+			outcome.Corporate = &result
+		}
+
+		// Players without a dealt personal objective only need to escape
+		outcome.Victory = outcome.Escaped && (outcome.Personal == nil || outcome.Personal.Complete)
+		outcomes = append(outcomes, outcome)
+	}
+	return outcomes
+}
+
+// recordKill credits a player with killing an enemy
+func recordKill(player *PlayerState, enemyType EnemyType) {
+	if player.Kills == nil {
+		player.Kills = make(map[EnemyType]int)
+	}
+	player.Kills[enemyType]++
+}
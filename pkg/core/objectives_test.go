@@ -0,0 +1,206 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func withTestObjectives(t *testing.T, objectives ...Objective) {
+	t.Helper()
+	previous := ObjectiveDB
+	ObjectiveDB = make(map[ObjectiveID]Objective)
+	for _, objective := range objectives {
+		ObjectiveDB[objective.ID] = objective
+	}
+	t.Cleanup(func() { ObjectiveDB = previous })
+}
+
+func TestLoadObjectivesFromDataDir(t *testing.T) {
+	previous := ObjectiveDB
+	defer func() { ObjectiveDB = previous }()
+
+	if err := LoadObjectives("../../data"); err != nil {
+		t.Fatalf("failed to load objectives: %v", err)
+	}
+	if len(objectiveIDsByScope(PersonalObjective)) == 0 {
+		t.Error("expected personal objectives in catalogue")
+	}
+	if len(objectiveIDsByScope(CorporateObjective)) == 0 {
+		t.Error("expected corporate objectives in catalogue")
+	}
+
+	hunter, exists := GetObjective("OBJ_OVERFLOW_HUNTER")
+	if !exists {
+		t.Fatal("expected OBJ_OVERFLOW_HUNTER to be loaded")
+	}
+	if hunter.Kind != ObjKillEnemies || hunter.Enemy == nil || *hunter.Enemy != StackOverflow || hunter.N != 3 {
+		t.Errorf("unexpected OBJ_OVERFLOW_HUNTER definition: %+v", hunter)
+	}
+}
+
+func TestLoadObjectivesRejectsUnknownKind(t *testing.T) {
+	previous := ObjectiveDB
+	defer func() { ObjectiveDB = previous }()
+
+	dir := t.TempDir()
+	content := "objectives:\n  personal:\n    - id: \"BAD\"\n      kind: \"win_the_lottery\"\n      n: 1\n"
+	if err := os.WriteFile(filepath.Join(dir, "objectives.yaml"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := LoadObjectives(dir); err == nil {
+		t.Error("expected error for unknown objective kind")
+	}
+}
+
+func TestInitializeGameDealsObjectives(t *testing.T) {
+	withTestObjectives(t,
+		Objective{ID: "P_A", Scope: PersonalObjective, Kind: ObjKillEnemies, N: 1},
+		Objective{ID: "P_B", Scope: PersonalObjective, Kind: ObjEscapeWithHP, N: 2},
+		Objective{ID: "C_A", Scope: CorporateObjective, Kind: ObjAllSurvive, N: 1},
+	)
+
+	state := initializeGameState(42, Frontend, Backend)
+
+	p1, p2 := state.Players["P1"], state.Players["P2"]
+	if p1.PersonalObj == "" || p2.PersonalObj == "" {
+		t.Fatal("expected every player to receive a personal objective")
+	}
+	if p1.PersonalObj == p2.PersonalObj {
+		t.Error("expected personal objectives to be unique per player")
+	}
+	if p1.CorporateObj != "C_A" || p2.CorporateObj != "C_A" {
+		t.Errorf("expected corporate objective C_A, got %s and %s", p1.CorporateObj, p2.CorporateObj)
+	}
+
+	again := initializeGameState(42, Frontend, Backend)
+	if again.Players["P1"].PersonalObj != p1.PersonalObj {
+		t.Error("expected objective deal to be deterministic for the same seed")
+	}
+}
+
+func TestEvaluateObjective(t *testing.T) {
+	overflow := StackOverflow
+	tests := []struct {
+		name      string
+		objective Objective
+		teamWin   bool
+		setup     func(*GameState)
+		want      bool
+	}{
+		{
+			name:      "kill specific enemy type complete",
+			objective: Objective{Kind: ObjKillEnemies, Enemy: &overflow, N: 3},
+			setup:     func(s *GameState) { s.Players["P1"].Kills = map[EnemyType]int{StackOverflow: 3, InfiniteLoop: 5} },
+			want:      true,
+		},
+		{
+			name:      "kill specific enemy type ignores other kills",
+			objective: Objective{Kind: ObjKillEnemies, Enemy: &overflow, N: 3},
+			setup:     func(s *GameState) { s.Players["P1"].Kills = map[EnemyType]int{StackOverflow: 2, InfiniteLoop: 5} },
+			want:      false,
+		},
+		{
+			name:      "kill any enemy counts every type",
+			objective: Objective{Kind: ObjKillEnemies, N: 4},
+			setup:     func(s *GameState) { s.Players["P1"].Kills = map[EnemyType]int{StackOverflow: 1, InfiniteLoop: 3} },
+			want:      true,
+		},
+		{
+			name:      "escape with hp requires team win",
+			objective: Objective{Kind: ObjEscapeWithHP, N: 2},
+			teamWin:   false,
+			setup:     func(s *GameState) {},
+			want:      false,
+		},
+		{
+			name:      "escape with hp complete",
+			objective: Objective{Kind: ObjEscapeWithHP, N: 2},
+			teamWin:   true,
+			setup:     func(s *GameState) {},
+			want:      true,
+		},
+		{
+			name:      "escape with too little hp",
+			objective: Objective{Kind: ObjEscapeWithHP, N: 2},
+			teamWin:   true,
+			setup:     func(s *GameState) { s.Players["P1"].HP = 1 },
+			want:      false,
+		},
+		{
+			name:      "no corrupted rooms complete",
+			objective: Objective{Kind: ObjMaxCorruptedRooms, N: 0},
+			setup:     func(s *GameState) {},
+			want:      true,
+		},
+		{
+			name:      "no corrupted rooms failed",
+			objective: Objective{Kind: ObjMaxCorruptedRooms, N: 0},
+			setup:     func(s *GameState) { s.Rooms["R15"].Corrupted = true },
+			want:      false,
+		},
+		{
+			name:      "explore rooms",
+			objective: Objective{Kind: ObjExploreRooms, N: 2},
+			setup: func(s *GameState) {
+				s.Rooms["R12"].Explored = true
+				s.Rooms["R15"].Explored = true
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := newWinTestGameState()
+			tt.setup(&state)
+
+			result := EvaluateObjective(&state, state.Players["P1"], tt.objective, tt.teamWin)
+			if result.Complete != tt.want {
+				t.Errorf("expected complete=%v, got %v (progress %s)", tt.want, result.Complete, result.Progress)
+			}
+		})
+	}
+}
+
+func TestEvaluateOutcomesRequiresPersonalObjectiveForVictory(t *testing.T) {
+	withTestObjectives(t,
+		Objective{ID: "P_KILL", Scope: PersonalObjective, Kind: ObjKillEnemies, N: 1},
+	)
+
+	state := newWinTestGameState()
+	state.Players["P1"].PersonalObj = "P_KILL"
+
+	outcomes := EvaluateOutcomes(&state, true)
+	if len(outcomes) != 1 {
+		t.Fatalf("expected 1 outcome, got %d", len(outcomes))
+	}
+	if !outcomes[0].Escaped || outcomes[0].Victory {
+		t.Errorf("expected escape without victory, got %+v", outcomes[0])
+	}
+
+	state.Players["P1"].Kills = map[EnemyType]int{InfiniteLoop: 1}
+	outcomes = EvaluateOutcomes(&state, true)
+	if !outcomes[0].Victory {
+		t.Error("expected victory once personal objective is complete")
+	}
+}
+
+func TestMeleeKillIsCreditedToPlayer(t *testing.T) {
+	state := newCombatTestGameState()
+	player := state.Players["P1"]
+	player.Damage = 5
+	state.Enemies = map[EnemyID]*Enemy{
+		"E1": {ID: "E1", Type: StackOverflow, HP: 3, MaxHP: 3, Location: player.Location},
+	}
+
+	result := ApplyCombat(state, MeleeAction{PlayerID: "P1"}, NewEffectLog())
+
+	if got := result.Players["P1"].Kills[StackOverflow]; got != 1 {
+		t.Errorf("expected 1 Stack Overflow kill credited, got %d", got)
+	}
+	if state.Players["P1"].Kills != nil {
+		t.Error("combat must not mutate the original state's kill counts")
+	}
+}
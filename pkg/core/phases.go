@@ -3,36 +3,90 @@ package core
 import (
 	"fmt"
 	"math/rand"
+	"sort"
 )
 
-// DrawPhase draws cards for the active player (5 on turn 1, 2 on subsequent turns)
+// DrawPhase draws cards for every player (5 on turn 1, 2 on subsequent turns)
+// and hands the first turn of the round to the first living player
 func DrawPhase(state *GameState) {
-	player := GetActivePlayer(state)
-	if player == nil {
-		return
+	rng := GetGameRNG(state)
+	for _, playerID := range seatOrder(state) {
+		player := state.Players[playerID]
+		
+		var cardsToDraw int
+		if state.Round == 1 {
+			// First turn: draw to 5 cards total
+			targetHandSize := 5
+			cardsToDraw = targetHandSize - len(player.Hand)
+		} else {
+			// Subsequent turns: draw exactly 2 cards
+			cardsToDraw = 2
+		}
+		
+		if cardsToDraw > 0 {
+			drawCards(&player.Hand, &player.Deck, &player.Discard, cardsToDraw, rng)
+			
+			// Enforce hand limit if drawing would exceed it
+			enforceHandLimitWithDiscard(&player.Hand, &player.Discard)
+		}
 	}
 	
-	var cardsToDraw int
-	if state.Round == 1 {
-		// First turn: draw to 5 cards total
-		targetHandSize := 5
-		cardsToDraw = targetHandSize - len(player.Hand)
-	} else {
-		// Subsequent turns: draw exactly 2 cards
-		cardsToDraw = 2
+	// Set actions for player phase
+	if first := nextPlayerToAct(state); first != "" {
+		state.ActivePlayer = first
+	}
+	state.ActionsLeft = ActionsPerTurn
+	state.Phase = "player"
+}
+
+// TurnOrder returns the living players in seat order (P1, P2, ...)
+func TurnOrder(state *GameState) []PlayerID {
+	order := make([]PlayerID, 0, len(state.Players))
+	for _, playerID := range seatOrder(state) {
+		if state.Players[playerID].HP > 0 {
+			order = append(order, playerID)
+		}
+	}
+	return order
+}
+
+// seatOrder returns every player ID, living or dead, sorted by seat
+func seatOrder(state *GameState) []PlayerID {
+	order := make([]PlayerID, 0, len(state.Players))
+	for playerID := range state.Players {
+		order = append(order, playerID)
+	}
+	sort.Slice(order, func(i, j int) bool { return order[i] < order[j] })
+	return order
+}
+
+// AdvanceTurn ends the active player's turn and passes control to the next living
+// player who has not acted this round. Returns false when every player has acted
+// and the round should move on to the event phase.
+func AdvanceTurn(state *GameState) bool {
+	if player := GetActivePlayer(state); player != nil {
+		player.HasActed = true
 	}
 	
-	if cardsToDraw > 0 {
-		rng := rand.New(rand.NewSource(state.RandSeed + int64(state.Round)*100))
-		drawCards(&player.Hand, &player.Deck, &player.Discard, cardsToDraw, rng)
-		
-		// Enforce hand limit if drawing would exceed it
-		enforceHandLimitWithDiscard(&player.Hand, &player.Discard)
+	next := nextPlayerToAct(state)
+	if next == "" {
+		state.ActionsLeft = 0
+		return false
 	}
 	
-	// Set actions for player phase
-	state.ActionsLeft = 2
-	state.Phase = "player"
+	state.ActivePlayer = next
+	state.ActionsLeft = ActionsPerTurn
+	return true
+}
+
+// nextPlayerToAct returns the first living player in seat order who has not acted yet
+func nextPlayerToAct(state *GameState) PlayerID {
+	for _, playerID := range TurnOrder(state) {
+		if !state.Players[playerID].HasActed {
+			return playerID
+		}
+	}
+	return ""
 }
 
 // EventPhase executes the 6-step event sequence from ruleset with logging
@@ -46,9 +100,9 @@ func EventPhase(state *GameState, log *EffectLog) {
 	state.Time--
 	log.Add("⏰ Step 1: Time passes - Round %d → %d", oldTime, state.Time)
 	
-	// Step 2: Malware attacks co-located developers
-	log.Add("👹 Step 2: Malware attacks...")
-	malwareAttackPhase(state, log)
+	// Step 2: Enemies activate - attack co-located developers, hunt noise or lurk
+	log.Add("👹 Step 2: Enemies activate...")
+	enemyActivationPhase(state, log)
 	
 	// Step 3: System crashes damage malware in OutOfRam rooms
 	log.Add("💥 Step 3: System crashes...")
@@ -66,6 +120,10 @@ func EventPhase(state *GameState, log *EffectLog) {
 	log.Add("👹 Corruption spawns...")
 	corruptedRoomSpawnPhase(state, log)
 	
+	// Step 5.6: Enemy abilities that fire at the end of the round
+	log.Add("✨ Enemy abilities...")
+	enemyRoundEndPhase(state, log)
+	
 	// Step 6: Check End Triggers (handled by caller)
 	log.Add("🎯 Step 6: End condition checks...")
 }
@@ -109,36 +167,45 @@ func CheckEndSolo(state *GameState) (ended bool, win bool) {
 	return false, false
 }
 
-// Helper functions for event phase steps
-
-func malwareAttackPhase(state *GameState, log *EffectLog) {
-	// For each enemy, attack any co-located players
-	attacksOccurred := false
-	for _, enemy := range state.Enemies {
-		for _, player := range state.Players {
-			if player.Location == enemy.Location && player.HP > 0 {
-				// Apply enemy damage
-				oldHP := player.HP
-				damage := enemy.Damage
-				if player.HP <= damage {
-					player.HP = 0
-				} else {
-					player.HP -= damage
-				}
-				log.Add("💔 %s attacks %s in %s! HP: %d → %d", getEnemyDisplayName(enemy.Type), player.ID, player.Location, oldHP, player.HP)
-				attacksOccurred = true
-			}
+// CheckEndMultiplayer checks team win/loss conditions for hot-seat play
+func CheckEndMultiplayer(state *GameState) (ended bool, win bool) {
+	if len(state.Players) == 0 {
+		return true, false // No players = loss
+	}
+	
+	// Win condition: any developer activated the engine - the whole team escapes
+	for _, player := range state.Players {
+		if player.EngineUsed {
+			return true, true
 		}
 	}
-	if !attacksOccurred {
-		log.Add("✅ No co-located enemies - players are safe")
+	
+	// Loss conditions
+	if len(TurnOrder(state)) == 0 {
+		return true, false // Every developer is dead
 	}
+	if state.Time <= 0 {
+		return true, false // Time up = loss
+	}
+	
+	return false, false
+}
+
+// CheckEnd picks the solo or team end check based on the number of players
+func CheckEnd(state *GameState) (ended bool, win bool) {
+	if len(state.Players) <= 1 {
+		return CheckEndSolo(state)
+	}
+	return CheckEndMultiplayer(state)
 }
 
+// Helper functions for event phase steps
+
 func systemCrashPhase(state *GameState, log *EffectLog) {
 	// Damage all malware in OutOfRam rooms
 	crashesOccurred := false
-	for enemyID, enemy := range state.Enemies {
+	for _, enemyID := range sortedEnemyIDs(state) {
+		enemy := state.Enemies[enemyID]
 		room := state.Rooms[enemy.Location]
 		if room != nil && room.OutOfRam {
 			oldHP := enemy.HP
@@ -146,11 +213,14 @@ func systemCrashPhase(state *GameState, log *EffectLog) {
 			
 			// Check if damage would kill the enemy (prevent uint8 underflow)
 			if enemy.HP <= damage {
+				enemy.HP = 0
 				log.Add("💥 %s destroyed by system crash in %s!", getEnemyDisplayName(enemy.Type), enemy.Location)
+				enemyHit(state, enemy, oldHP, log)
 				delete(state.Enemies, enemyID)
 			} else {
 				enemy.HP -= damage
 				log.Add("💥 %s damaged by system crash in %s! HP: %d → %d", getEnemyDisplayName(enemy.Type), enemy.Location, oldHP, enemy.HP)
+				enemyHit(state, enemy, oldHP, log)
 			}
 			crashesOccurred = true
 		}
@@ -191,7 +261,7 @@ func enemyDevelopmentPhase(state *GameState, log *EffectLog) {
 	drawCount := (state.Round + 1) / 2
 	log.Add("🧬 Drawing %d enemy tokens (round %d)", drawCount, state.Round)
 	
-	rng := rand.New(rand.NewSource(state.RandSeed + int64(state.Round)*1000))
+	rng := GetGameRNG(state)
 	
 	spawned := 0
 	for i := 0; i < drawCount; i++ {
@@ -209,9 +279,9 @@ func enemyDevelopmentPhase(state *GameState, log *EffectLog) {
 			state.SpawnBag.Tokens[:tokenIndex], 
 			state.SpawnBag.Tokens[tokenIndex+1:]...)
 		
-		// Spawn enemy if not NoSpawn
-		if token != InfiniteLoop && token != StackOverflow && token != Pythogoras {
-			continue // Skip invalid tokens
+		// Skip tokens of types no longer defined
+		if _, exists := EnemyDB[token]; !exists {
+			continue
 		}
 		
 		// Spawn the enemy
@@ -231,31 +301,25 @@ func enemyDevelopmentPhase(state *GameState, log *EffectLog) {
 
 func spawnEnemy(state *GameState, enemyType EnemyType, rng *rand.Rand, log *EffectLog) RoomID {
 	// Find a random room to spawn in
-	roomIDs := make([]RoomID, 0, len(state.Rooms))
-	for roomID := range state.Rooms {
-		roomIDs = append(roomIDs, roomID)
-	}
+	roomIDs := sortedRoomIDs(state)
 	
 	if len(roomIDs) == 0 {
 		return ""
 	}
 	
 	spawnRoom := roomIDs[rng.Intn(len(roomIDs))]
-	stats := ENEMY_STATS[enemyType]
 	
 	// Generate unique enemy ID
-	enemyID := EnemyID(fmt.Sprintf("%s_%d_%d", getEnemyTypeName(enemyType), state.Round, rng.Int31()))
-	
-	state.Enemies[enemyID] = &Enemy{
-		ID:       enemyID,
-		Type:     enemyType,
-		HP:       stats.HP,
-		MaxHP:    stats.HP,
-		Damage:   stats.Damage,
-		Location: spawnRoom,
+	enemyID := EnemyID(fmt.Sprintf("%s_%d_%d", enemyDef(enemyType).IDPrefix, state.Round, rng.Int31()))
+	
+	enemy, exists := newEnemy(enemyID, enemyType, spawnRoom)
+	if !exists {
+		return ""
 	}
+	state.Enemies[enemyID] = enemy
 	
 	log.Add("👹 %s spawned in %s", getEnemyDisplayName(enemyType), spawnRoom)
+	triggerAbilities(state, enemy, OnSpawn, log)
 	return spawnRoom
 }
 
@@ -269,18 +333,14 @@ func applyEventEffect(state *GameState, effect Effect, log *EffectLog) {
 	ApplyEffect(state, effect, playerID, log)
 }
 
+// addStrongerToken puts the drawn type's upgrade (see EnemyDef.Upgrade) back
+// in the spawn bag
 func addStrongerToken(bag *SpawnBag, token EnemyType, log *EffectLog) {
-	var stronger EnemyType
-	switch token {
-	case InfiniteLoop:
-		stronger = StackOverflow
-	case StackOverflow:
-		stronger = Pythogoras
-	case Pythogoras:
-		stronger = Pythogoras // Pythogoras is max level
-	default:
+	upgrade := enemyDef(token).Upgrade
+	if upgrade == nil {
 		return
 	}
+	stronger := *upgrade
 	
 	bag.Tokens = append(bag.Tokens, stronger)
 	log.Add("🧬 %s token added to spawn bag", getEnemyDisplayName(stronger))
@@ -289,23 +349,19 @@ func addStrongerToken(bag *SpawnBag, token EnemyType, log *EffectLog) {
 func corruptedRoomSpawnPhase(state *GameState, log *EffectLog) {
 	spawnCount := 0
 	
-	for _, room := range state.Rooms {
+	for _, roomID := range sortedRoomIDs(state) {
+		room := state.Rooms[roomID]
 		if room.Corrupted && !room.OutOfRam {
 			// Spawn Infinite Loop (weakest enemy) in each corrupted room
 			enemyID := EnemyID(fmt.Sprintf("CORRUPT_%s_%d", room.ID, state.Round))
-			stats := ENEMY_STATS[InfiniteLoop]
-			
-			enemy := &Enemy{
-				ID:       enemyID,
-				Type:     InfiniteLoop,
-				HP:       stats.HP,
-				MaxHP:    stats.HP,
-				Damage:   stats.Damage,
-				Location: room.ID,
+			enemy, exists := newEnemy(enemyID, InfiniteLoop, room.ID)
+			if !exists {
+				continue
 			}
 			state.Enemies[enemyID] = enemy
 			
-			log.Add("👹 Infinite Loop spawned in corrupted %s", room.ID)
+			log.Add("👹 %s spawned in corrupted %s", getEnemyDisplayName(InfiniteLoop), room.ID)
+			triggerAbilities(state, enemy, OnSpawn, log)
 			spawnCount++
 		}
 	}
@@ -317,17 +373,4 @@ func corruptedRoomSpawnPhase(state *GameState, log *EffectLog) {
 	}
 }
 
-func getEnemyTypeName(enemyType EnemyType) string {
-	switch enemyType {
-	case InfiniteLoop:
-		return "LOOP"
-	case StackOverflow:
-		return "OVERFLOW"
-	case Pythogoras:
-		return "PYTHO"
-	default:
-		return "UNKNOWN"
-	}
-}
-
 
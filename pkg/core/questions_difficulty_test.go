@@ -0,0 +1,126 @@
+package core
+
+import (
+	"testing"
+)
+
+// withDifficultyQuestions installs one question per difficulty: 1 easy, 2 normal, 3 hard
+func withDifficultyQuestions(t *testing.T) {
+	t.Helper()
+	previous := QuestionDB
+	t.Cleanup(func() { QuestionDB = previous })
+
+	QuestionDB = map[int]Question{}
+	for id, difficulty := range map[int]Difficulty{1: Easy, 2: Normal, 3: Hard} {
+		QuestionDB[id] = Question{ID: id, Text: "Q", Options: []string{"a", "b"}, CorrectAnswer: 0, Category: "go", Difficulty: difficulty}
+	}
+}
+
+func TestQuestionDifficultyFor(t *testing.T) {
+	tests := []struct {
+		name string
+		hp   uint8
+		to   RoomID
+		want Difficulty
+	}{
+		{"ordinary room", 5, "R07", Normal},
+		{"engine room", 5, "R15", Hard},
+		{"key room", 5, "R01", Hard},
+		{"low HP", LowHPThreshold, "R07", Easy},
+		{"low HP at the key room", 1, "R01", Normal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := checkedTestState()
+			state.Players["P1"].HP = tt.hp
+			if got := QuestionDifficultyFor(&state, "P1", tt.to); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+// newHighValueGame starts a game on corruptionMap and walks P1 from the start
+// room C into the escape room F, next to the engine room E and two rooms
+// from the key room D
+func newHighValueGame(t *testing.T) GameState {
+	t.Helper()
+	withTestMap(t, corruptionMap)
+	state := Apply(GameState{}, InitializeGameAction{Seed: 1, PlayerClasses: []DevClass{Backend}}, NewEffectLog())
+	for _, room := range state.Rooms {
+		room.BugMarkers, room.Corrupted = 0, false
+	}
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "F"}, NewEffectLog())
+	if state.Players["P1"].Location != "F" || state.PendingQuestion != nil {
+		t.Fatalf("expected P1 to walk into the explored escape room, got %s with %+v", state.Players["P1"].Location, state.PendingQuestion)
+	}
+	return state
+}
+
+func TestHighValueRoomAsksHardQuestion(t *testing.T) {
+	withDifficultyQuestions(t)
+	state := newHighValueGame(t)
+	for id, explored := range map[RoomID]bool{"C": true, "F": true, "D": false, "E": false} {
+		if state.Rooms[id].Explored != explored {
+			t.Errorf("expected %s explored=%v at the start of a game", id, explored)
+		}
+	}
+
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "E"}, NewEffectLog())
+
+	if state.PendingQuestion == nil || state.PendingQuestion.QuestionID != 3 {
+		t.Fatalf("expected the hard question at the engine room, got %+v", state.PendingQuestion)
+	}
+	if state.QuestionOrder[0] != 3 || state.NextQuestion != 1 {
+		t.Errorf("expected the hard question drawn first, got %v (next %d)", state.QuestionOrder, state.NextQuestion)
+	}
+}
+
+func TestMissingDifficultyFallsBackToClosest(t *testing.T) {
+	withDifficultyQuestions(t)
+	state := checkedTestState()
+	state.Players["P1"].HP = 1
+	state.QuestionOrder = []int{3, 2}
+
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+
+	if state.PendingQuestion == nil || state.PendingQuestion.QuestionID != 2 {
+		t.Errorf("expected the normal question when no easy one is left, got %+v", state.PendingQuestion)
+	}
+}
+
+func TestHardQuestionRewardsRareCard(t *testing.T) {
+	withDifficultyQuestions(t)
+	withTestCardDB(t,
+		Card{ID: "SPECIAL_COMMON", Name: "Common", Source: SrcSpecial, Rarity: "common"},
+		Card{ID: "SPECIAL_RARE", Name: "Rare", Source: SrcSpecial, Rarity: "rare"},
+	)
+	state := newHighValueGame(t)
+
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "E"}, NewEffectLog())
+	state = Apply(state, AnswerQuestionAction{PlayerID: "P1", Choice: 0}, NewEffectLog())
+
+	hand := state.Players["P1"].Hand
+	if state.Players["P1"].Location != "E" || len(hand) == 0 || hand[len(hand)-1] != "SPECIAL_RARE" {
+		t.Errorf("expected P1 in the engine room with the rare special card, got %s with hand %v", state.Players["P1"].Location, hand)
+	}
+}
+
+func TestMissedEasyQuestionOnlyBugsTargetRoom(t *testing.T) {
+	withDifficultyQuestions(t)
+	state := checkedTestState()
+	state.Players["P1"].HP = 1
+	state.QuestionOrder = []int{1}
+
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+	state = Apply(state, AnswerQuestionAction{PlayerID: "P1", Choice: 1}, NewEffectLog())
+
+	if state.Rooms["R07"].BugMarkers == 0 {
+		t.Error("expected bugs in the target room")
+	}
+	for _, adjacent := range GetAdjacentRooms("R07") {
+		if state.Rooms[adjacent].BugMarkers != 0 {
+			t.Errorf("expected no bugs in %s after a missed easy question", adjacent)
+		}
+	}
+}
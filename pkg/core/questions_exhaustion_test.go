@@ -5,6 +5,7 @@ import (
 )
 
 func TestQuestionExhaustion_50Questions(t *testing.T) {
+	withTestQuestions(t)
 	// Initialize game state with pre-shuffled questions
 	state := initializeGameState(42, Frontend)
 	
@@ -54,6 +55,7 @@ func TestQuestionExhaustion_50Questions(t *testing.T) {
 }
 
 func TestQuestionExhaustion_51stQuestion(t *testing.T) {
+	withTestQuestions(t)
 	// Initialize game state with pre-shuffled questions
 	state := initializeGameState(42, Frontend)
 	currentState := state
@@ -89,6 +91,7 @@ func TestQuestionExhaustion_51stQuestion(t *testing.T) {
 }
 
 func TestQuestionOrder_Deterministic(t *testing.T) {
+	withTestQuestions(t)
 	// Same seed should produce same question order
 	state1 := initializeGameState(42, Frontend)
 	state2 := initializeGameState(42, Frontend)
@@ -106,6 +109,7 @@ func TestQuestionOrder_Deterministic(t *testing.T) {
 }
 
 func TestQuestionOrder_DifferentSeeds(t *testing.T) {
+	withTestQuestions(t)
 	// Different seeds should produce different question orders
 	state1 := initializeGameState(42, Frontend)
 	state2 := initializeGameState(100, Frontend)
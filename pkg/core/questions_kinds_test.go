@@ -0,0 +1,174 @@
+package core
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var (
+	multiTestQuestion = Question{ID: 1, Kind: MultiQuestion, Text: "Pick", Options: []string{"a", "b", "c"}, CorrectAnswers: []int{0, 2}, Category: "go", Difficulty: Normal}
+	textTestQuestion  = Question{ID: 2, Kind: TextQuestion, Text: "Type", Accepted: []string{"make", "make()"}, Category: "go", Difficulty: Normal}
+)
+
+// withKindQuestions installs the multi and text test questions and puts P1
+// in front of the given one
+func withKindQuestions(t *testing.T, questionID int) GameState {
+	t.Helper()
+	previous := QuestionDB
+	t.Cleanup(func() { QuestionDB = previous })
+	QuestionDB = map[int]Question{1: multiTestQuestion, 2: textTestQuestion}
+
+	state := checkedTestState()
+	state.QuestionOrder = []int{questionID}
+	return Apply(state, MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+}
+
+func TestCheckResponse(t *testing.T) {
+	choice := Question{Options: []string{"a", "b"}, CorrectAnswer: 1}
+
+	tests := []struct {
+		name     string
+		question Question
+		answer   AnswerQuestionAction
+		want     bool
+	}{
+		{"choice correct", choice, AnswerQuestionAction{Choice: 1}, true},
+		{"choice wrong", choice, AnswerQuestionAction{Choice: 0}, false},
+		{"multi exact set in any order", multiTestQuestion, AnswerQuestionAction{Choices: []int{2, 0}}, true},
+		{"multi missing one", multiTestQuestion, AnswerQuestionAction{Choices: []int{0}}, false},
+		{"multi extra one", multiTestQuestion, AnswerQuestionAction{Choices: []int{0, 1, 2}}, false},
+		{"text exact", textTestQuestion, AnswerQuestionAction{Text: "make"}, true},
+		{"text normalised", textTestQuestion, AnswerQuestionAction{Text: "  `MAKE()`. "}, true},
+		{"text wrong", textTestQuestion, AnswerQuestionAction{Text: "new"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CheckResponse(tt.question, tt.answer); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeAnswer(t *testing.T) {
+	if got := NormalizeAnswer("  Structured   Query\tLanguage. "); got != "structured query language" {
+		t.Errorf("unexpected normalisation %q", got)
+	}
+	if got := NormalizeAnswer(`"0"`); got != "0" {
+		t.Errorf("expected quotes stripped, got %q", got)
+	}
+}
+
+func TestAnswerValidationByKind(t *testing.T) {
+	tests := []struct {
+		name     string
+		question int
+		answer   AnswerQuestionAction
+		want     error
+	}{
+		{"multi empty", 1, AnswerQuestionAction{PlayerID: "P1"}, ErrInvalidChoice},
+		{"multi out of range", 1, AnswerQuestionAction{PlayerID: "P1", Choices: []int{0, 3}}, ErrInvalidChoice},
+		{"multi duplicate", 1, AnswerQuestionAction{PlayerID: "P1", Choices: []int{0, 0}}, ErrInvalidChoice},
+		{"multi valid", 1, AnswerQuestionAction{PlayerID: "P1", Choices: []int{1}}, nil},
+		{"text empty", 2, AnswerQuestionAction{PlayerID: "P1", Text: " '' "}, ErrInvalidChoice},
+		{"text valid", 2, AnswerQuestionAction{PlayerID: "P1", Text: "new"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := withKindQuestions(t, tt.question)
+			if err := ValidateAction(&state, tt.answer); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestTextAnswerResolvesMove(t *testing.T) {
+	withTestCardDB(t)
+	state := withKindQuestions(t, 2)
+
+	state, err := ApplyChecked(state, AnswerQuestionAction{PlayerID: "P1", Text: "Make"}, NewEffectLog())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if player := state.Players["P1"]; player.Location != "R07" || len(player.Hand) != 1 {
+		t.Errorf("expected a correct answer to move P1 and keep the hand, got %s with hand %v", player.Location, player.Hand)
+	}
+}
+
+func TestLegalActionsForQuestionKinds(t *testing.T) {
+	state := withKindQuestions(t, 1)
+	options := LegalActions(&state)
+	if len(options) != 7 {
+		t.Fatalf("expected every non-empty set of 3 options, got %d", len(options))
+	}
+	if want := (AnswerQuestionAction{PlayerID: "P1", Choices: []int{0, 2}}); !reflect.DeepEqual(options[4].Action, want) {
+		t.Errorf("expected set 101 to be %+v, got %+v", want, options[4].Action)
+	}
+
+	state = withKindQuestions(t, 2)
+	options = LegalActions(&state)
+	if len(options) != 1 || !options[0].NeedsText || !options[0].Legal() {
+		t.Errorf("expected a single text answer template, got %+v", options)
+	}
+}
+
+func TestLoadQuestionKinds(t *testing.T) {
+	previous := QuestionDB
+	t.Cleanup(func() { QuestionDB = previous })
+
+	dir := t.TempDir()
+	writeQuestionFile(t, dir, "questions.yaml", `
+questions:
+  - id: 1
+    kind: multi
+    category: go
+    text: "Which are reference types?"
+    options: ["map", "int", "slice"]
+    answers: [2, 0]
+  - id: 2
+    kind: text
+    category: go
+    text: "What does this print?"
+    code: |
+      for i := 0; i < 3; i++ {
+          fmt.Print(i)
+      }
+    accepted: ["012"]
+`)
+	if err := LoadQuestions(dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := QuestionDB[1].CorrectAnswers; !reflect.DeepEqual(got, []int{0, 2}) {
+		t.Errorf("expected sorted answers [0 2], got %v", got)
+	}
+	if code := QuestionDB[2].Code; !strings.HasPrefix(code, "for i") || !strings.Contains(code, "\n    fmt.Print(i)") {
+		t.Errorf("expected the code block with its indentation, got %q", code)
+	}
+
+	invalid := []struct {
+		name     string
+		question string
+		want     string
+	}{
+		{"unknown kind", "kind: essay\n    accepted: [x]", "unknown kind"},
+		{"multi without answers", "kind: multi\n    options: [a, b]", "missing answers"},
+		{"multi with answer", "kind: multi\n    options: [a, b]\n    answer: 0\n    answers: [0]", "under answers"},
+		{"multi out of range", "kind: multi\n    options: [a, b]\n    answers: [0, 2]", "out of range"},
+		{"multi duplicate", "kind: multi\n    options: [a, b]\n    answers: [1, 1]", "twice"},
+		{"text without accepted", "kind: text", "missing accepted"},
+		{"text with options", "kind: text\n    options: [a, b]\n    accepted: [a]", "not options"},
+		{"text blank accepted", "kind: text\n    accepted: [\"  \"]", "is empty"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeQuestionFile(t, dir, "questions.yaml", "questions:\n  - id: 1\n    category: go\n    text: \"Q\"\n    "+tt.question+"\n")
+			if err := LoadQuestions(dir); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected an error mentioning %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
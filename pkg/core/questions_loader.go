@@ -0,0 +1,220 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Option count limits for a question
+const (
+	MinQuestionOptions = 2
+	MaxQuestionOptions = 6
+)
+
+// QuestionDatabase represents the YAML structure of a question file or pack
+type QuestionDatabase struct {
+	Questions []YAMLQuestion `yaml:"questions"`
+}
+
+// YAMLQuestion represents a question as stored in YAML
+type YAMLQuestion struct {
+	ID          *int     `yaml:"id"`
+	Kind        string   `yaml:"kind,omitempty"`
+	Category    string   `yaml:"category"`
+	Difficulty  string   `yaml:"difficulty,omitempty"`
+	Text        string   `yaml:"text"`
+	Code        string   `yaml:"code,omitempty"`
+	Options     []string `yaml:"options"`
+	Answer      *int     `yaml:"answer"`             // choice questions
+	Answers     []int    `yaml:"answers,omitempty"`  // multi questions
+	Accepted    []string `yaml:"accepted,omitempty"` // text questions
+	Explanation string   `yaml:"explanation,omitempty"`
+}
+
+var QuestionDB map[int]Question
+
+// LoadQuestions loads the question bank from questions.yaml and any packs in
+// the questions/ directory, read in file name order. At least one of them
+// must exist, and question IDs must be unique across all files.
+func LoadQuestions(dataPath string) error {
+	files := []string{}
+	mainFile := filepath.Join(dataPath, "questions.yaml")
+	if _, err := os.Stat(mainFile); err == nil {
+		files = append(files, mainFile)
+	}
+	packs, err := filepath.Glob(filepath.Join(dataPath, "questions", "*.yaml"))
+	if err != nil {
+		return fmt.Errorf("failed to list question packs: %w", err)
+	}
+	sort.Strings(packs)
+	files = append(files, packs...)
+	if len(files) == 0 {
+		return fmt.Errorf("no questions found: expected %s or packs in %s", mainFile, filepath.Join(dataPath, "questions"))
+	}
+
+	questions := make(map[int]Question)
+	for _, file := range files {
+		if err := loadQuestionFile(file, questions); err != nil {
+			return err
+		}
+	}
+	if len(questions) == 0 {
+		return fmt.Errorf("no questions found in %s", dataPath)
+	}
+
+	QuestionDB = questions
+	return nil
+}
+
+// loadQuestionFile adds the questions of one file to questions
+func loadQuestionFile(path string, questions map[int]Question) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read questions file: %w", err)
+	}
+
+	var db QuestionDatabase
+	if err := yaml.Unmarshal(data, &db); err != nil {
+		return fmt.Errorf("failed to parse questions YAML %s: %w", filepath.Base(path), err)
+	}
+
+	for i, yamlQuestion := range db.Questions {
+		question, err := convertYAMLToQuestion(yamlQuestion)
+		if err != nil {
+			return fmt.Errorf("failed to convert question #%d in %s: %w", i+1, filepath.Base(path), err)
+		}
+		if _, duplicate := questions[question.ID]; duplicate {
+			return fmt.Errorf("duplicate question id %d in %s", question.ID, filepath.Base(path))
+		}
+		questions[question.ID] = question
+	}
+	return nil
+}
+
+// convertYAMLToQuestion converts YAML question format to core.Question
+func convertYAMLToQuestion(yamlQuestion YAMLQuestion) (Question, error) {
+	if yamlQuestion.ID == nil {
+		return Question{}, fmt.Errorf("missing id")
+	}
+	if *yamlQuestion.ID < 0 {
+		return Question{}, fmt.Errorf("id %d must not be negative", *yamlQuestion.ID)
+	}
+
+	question := Question{
+		ID:          *yamlQuestion.ID,
+		Kind:        ChoiceQuestion,
+		Text:        strings.TrimSpace(yamlQuestion.Text),
+		Code:        strings.TrimRight(yamlQuestion.Code, " \t\n"),
+		Options:     yamlQuestion.Options,
+		Category:    strings.ToLower(strings.TrimSpace(yamlQuestion.Category)),
+		Difficulty:  Normal,
+		Explanation: strings.TrimSpace(yamlQuestion.Explanation),
+	}
+
+	if question.Text == "" {
+		return Question{}, fmt.Errorf("question %d: missing text", question.ID)
+	}
+	if question.Category == "" {
+		return Question{}, fmt.Errorf("question %d: missing category", question.ID)
+	}
+	if yamlQuestion.Difficulty != "" {
+		difficulty, err := ParseDifficulty(yamlQuestion.Difficulty)
+		if err != nil {
+			return Question{}, fmt.Errorf("question %d: %w", question.ID, err)
+		}
+		question.Difficulty = difficulty
+	}
+
+	if yamlQuestion.Kind != "" {
+		question.Kind = QuestionKind(strings.ToLower(yamlQuestion.Kind))
+	}
+	switch question.Kind {
+	case ChoiceQuestion:
+		if err := validateQuestionOptions(question); err != nil {
+			return Question{}, err
+		}
+		if yamlQuestion.Answer == nil {
+			return Question{}, fmt.Errorf("question %d: missing answer", question.ID)
+		}
+		if *yamlQuestion.Answer < 0 || *yamlQuestion.Answer >= len(question.Options) {
+			return Question{}, fmt.Errorf("question %d: answer %d is out of range (0-%d)",
+				question.ID, *yamlQuestion.Answer, len(question.Options)-1)
+		}
+		question.CorrectAnswer = *yamlQuestion.Answer
+
+	case MultiQuestion:
+		if err := validateQuestionOptions(question); err != nil {
+			return Question{}, err
+		}
+		if yamlQuestion.Answer != nil {
+			return Question{}, fmt.Errorf("question %d: multi questions list their answers under answers", question.ID)
+		}
+		if len(yamlQuestion.Answers) == 0 {
+			return Question{}, fmt.Errorf("question %d: missing answers", question.ID)
+		}
+		seen := make(map[int]bool)
+		for _, answer := range yamlQuestion.Answers {
+			if answer < 0 || answer >= len(question.Options) {
+				return Question{}, fmt.Errorf("question %d: answer %d is out of range (0-%d)",
+					question.ID, answer, len(question.Options)-1)
+			}
+			if seen[answer] {
+				return Question{}, fmt.Errorf("question %d: answer %d is listed twice", question.ID, answer)
+			}
+			seen[answer] = true
+		}
+		question.CorrectAnswers = append([]int(nil), yamlQuestion.Answers...)
+		sort.Ints(question.CorrectAnswers)
+		question.CorrectAnswer = -1
+
+	case TextQuestion:
+		if len(question.Options) > 0 || yamlQuestion.Answer != nil || len(yamlQuestion.Answers) > 0 {
+			return Question{}, fmt.Errorf("question %d: text questions take accepted values, not options", question.ID)
+		}
+		if len(yamlQuestion.Accepted) == 0 {
+			return Question{}, fmt.Errorf("question %d: missing accepted answers", question.ID)
+		}
+		for i, accepted := range yamlQuestion.Accepted {
+			if NormalizeAnswer(accepted) == "" {
+				return Question{}, fmt.Errorf("question %d: accepted answer %d is empty", question.ID, i+1)
+			}
+		}
+		question.Accepted = yamlQuestion.Accepted
+		question.CorrectAnswer = -1
+
+	default:
+		return Question{}, fmt.Errorf("question %d: unknown kind %q (use choice, multi or text)", question.ID, yamlQuestion.Kind)
+	}
+
+	return question, nil
+}
+
+// validateQuestionOptions checks the option list of a choice or multi question
+func validateQuestionOptions(question Question) error {
+	if len(question.Options) < MinQuestionOptions || len(question.Options) > MaxQuestionOptions {
+		return fmt.Errorf("question %d: has %d options, need %d-%d",
+			question.ID, len(question.Options), MinQuestionOptions, MaxQuestionOptions)
+	}
+	for i, option := range question.Options {
+		if strings.TrimSpace(option) == "" {
+			return fmt.Errorf("question %d: option %d is empty", question.ID, i+1)
+		}
+	}
+	return nil
+}
+
+// sortedQuestionIDs returns the loaded question IDs in ascending order
+func sortedQuestionIDs() []int {
+	ids := make([]int, 0, len(QuestionDB))
+	for id := range QuestionDB {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
@@ -0,0 +1,152 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// withTestQuestions loads the shipped question bank for the duration of a test
+func withTestQuestions(t *testing.T) {
+	t.Helper()
+	previous := QuestionDB
+	t.Cleanup(func() { QuestionDB = previous })
+
+	if err := LoadQuestions("../../data"); err != nil {
+		t.Fatalf("failed to load questions: %v", err)
+	}
+}
+
+// writeQuestionFile writes a question file relative to dir
+func writeQuestionFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadQuestionsShippedBank(t *testing.T) {
+	withTestQuestions(t)
+
+	if len(QuestionDB) != 50 {
+		t.Fatalf("expected 50 shipped questions, got %d", len(QuestionDB))
+	}
+	for id, question := range QuestionDB {
+		if question.ID != id || question.Category == "" || question.Explanation == "" {
+			t.Errorf("question %d is incomplete: %+v", id, question)
+		}
+	}
+}
+
+func TestLoadQuestionsWithPacks(t *testing.T) {
+	previous := QuestionDB
+	t.Cleanup(func() { QuestionDB = previous })
+
+	dir := t.TempDir()
+	writeQuestionFile(t, dir, "questions.yaml", `
+questions:
+  - id: 0
+    category: Go
+    text: "Which keyword starts a goroutine?"
+    options: ["go", "async"]
+    answer: 0
+`)
+	writeQuestionFile(t, dir, "questions/ours.yaml", `
+questions:
+  - id: 1000
+    category: internal
+    difficulty: hard
+    text: "Which service owns billing?"
+    options: ["ledger", "vault", "relay"]
+    answer: 2
+    explanation: "relay took over billing last year."
+`)
+
+	if err := LoadQuestions(dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(QuestionDB) != 2 {
+		t.Fatalf("expected 2 questions, got %d", len(QuestionDB))
+	}
+	if question := QuestionDB[0]; question.Category != "go" || question.Difficulty != Normal {
+		t.Errorf("expected a lowercased category and normal difficulty by default, got %+v", question)
+	}
+	if question := QuestionDB[1000]; question.Difficulty != Hard || question.CorrectAnswer != 2 || question.Explanation == "" {
+		t.Errorf("pack question loaded wrong: %+v", question)
+	}
+
+	// The order only contains loaded IDs
+	state := initializeGameState(42, Frontend)
+	if len(state.QuestionOrder) != 2 {
+		t.Errorf("expected 2 questions in the order, got %v", state.QuestionOrder)
+	}
+}
+
+func TestLoadQuestionsRejectsInvalidQuestions(t *testing.T) {
+	previous := QuestionDB
+	t.Cleanup(func() { QuestionDB = previous })
+
+	valid := "category: go\n    text: \"Q\"\n    options: [\"a\", \"b\"]\n    answer: 1"
+	tests := []struct {
+		name     string
+		question string
+		want     string
+	}{
+		{"missing id", valid, "missing id"},
+		{"negative id", "id: -1\n    " + valid, "negative"},
+		{"missing text", "id: 1\n    category: go\n    options: [\"a\", \"b\"]\n    answer: 0", "missing text"},
+		{"missing category", "id: 1\n    text: \"Q\"\n    options: [\"a\", \"b\"]\n    answer: 0", "missing category"},
+		{"one option", "id: 1\n    category: go\n    text: \"Q\"\n    options: [\"a\"]\n    answer: 0", "options"},
+		{"too many options", "id: 1\n    category: go\n    text: \"Q\"\n    options: [a, b, c, d, e, f, g]\n    answer: 0", "options"},
+		{"empty option", "id: 1\n    category: go\n    text: \"Q\"\n    options: [\"a\", \"\"]\n    answer: 0", "empty"},
+		{"missing answer", "id: 1\n    category: go\n    text: \"Q\"\n    options: [\"a\", \"b\"]", "missing answer"},
+		{"answer out of range", "id: 1\n    category: go\n    text: \"Q\"\n    options: [\"a\", \"b\"]\n    answer: 2", "out of range"},
+		{"bad difficulty", "id: 1\n    difficulty: brutal\n    " + valid, "difficulty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeQuestionFile(t, dir, "questions.yaml", "questions:\n  - "+tt.question+"\n")
+
+			err := LoadQuestions(dir)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected an error mentioning %q, got %v", tt.want, err)
+			}
+		})
+	}
+
+	t.Run("duplicate id across packs", func(t *testing.T) {
+		dir := t.TempDir()
+		writeQuestionFile(t, dir, "questions.yaml", "questions:\n  - id: 7\n    "+valid+"\n")
+		writeQuestionFile(t, dir, "questions/pack.yaml", "questions:\n  - id: 7\n    "+valid+"\n")
+
+		if err := LoadQuestions(dir); err == nil || !strings.Contains(err.Error(), "duplicate question id 7") {
+			t.Errorf("expected a duplicate id error, got %v", err)
+		}
+	})
+
+	t.Run("no question files", func(t *testing.T) {
+		if err := LoadQuestions(t.TempDir()); err == nil {
+			t.Error("expected an error without any question files")
+		}
+	})
+}
+
+func TestRemovedQuestionsAreSkipped(t *testing.T) {
+	withTestQuestions(t)
+	state := newQuestionTestGameState()
+	state.QuestionOrder = []int{9999, 0}
+
+	if question := PeekQuestion(&state); question.ID != 0 {
+		t.Fatalf("expected the missing question to be skipped, got %d", question.ID)
+	}
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+	if state.PendingQuestion == nil || state.PendingQuestion.QuestionID != 0 || state.NextQuestion != 1 {
+		t.Errorf("expected question 0 to be asked, got %+v (next %d)", state.PendingQuestion, state.NextQuestion)
+	}
+}
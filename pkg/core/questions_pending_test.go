@@ -0,0 +1,187 @@
+package core
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// newQuestionTestGameState has P1 in R12 with R07 unexplored and two questions left
+func newQuestionTestGameState() GameState {
+	state := checkedTestState()
+	state.QuestionOrder = []int{3, 0}
+	state.Rooms["R07"].Type = AmmoCache
+	return state
+}
+
+// scriptedAnswer answers a question right or wrong, picking a wrong answer
+// from the question's own options
+func scriptedAnswer(question Question, playerID PlayerID, correct bool) AnswerQuestionAction {
+	answer := AnswerQuestionAction{PlayerID: playerID}
+	switch question.Kind {
+	case MultiQuestion:
+		answer.Choices = append(answer.Choices, question.CorrectAnswers...)
+		switch {
+		case correct:
+		case len(answer.Choices) > 1:
+			answer.Choices = answer.Choices[:len(answer.Choices)-1]
+		default:
+			for choice := range question.Options {
+				if choice != question.CorrectAnswers[0] {
+					answer.Choices = []int{choice}
+					break
+				}
+			}
+		}
+	case TextQuestion:
+		if correct {
+			answer.Text = question.Accepted[0]
+		}
+	default:
+		answer.Choice = question.CorrectAnswer
+		if !correct {
+			for choice := range question.Options {
+				if choice != question.CorrectAnswer {
+					answer.Choice = choice
+					break
+				}
+			}
+		}
+	}
+	return answer
+}
+
+func TestMoveIntoUnexploredRoomAsksQuestion(t *testing.T) {
+	withTestQuestions(t)
+	state := Apply(newQuestionTestGameState(), MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+
+	if state.Players["P1"].Location != "R12" {
+		t.Errorf("expected P1 to wait in R12, got %s", state.Players["P1"].Location)
+	}
+	want := PendingQuestion{PlayerID: "P1", To: "R07", QuestionID: 3}
+	if state.PendingQuestion == nil || *state.PendingQuestion != want {
+		t.Fatalf("expected pending question %+v, got %+v", want, state.PendingQuestion)
+	}
+	if state.NextQuestion != 1 {
+		t.Errorf("expected the question to be drawn, NextQuestion=%d", state.NextQuestion)
+	}
+	if question, ok := CurrentQuestion(&state); !ok || question.ID != 3 {
+		t.Errorf("expected CurrentQuestion to return question 3, got %d", question.ID)
+	}
+}
+
+func TestCorrectAnswerGrantsCardAndMoves(t *testing.T) {
+	withTestCardDB(t, Card{ID: "SPECIAL_TEST", Name: "Test Special", Source: SrcSpecial})
+	withTestQuestions(t)
+	state := Apply(newQuestionTestGameState(), MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+
+	state = Apply(state, AnswerQuestionAction{PlayerID: "P1", Choice: QuestionDB[3].CorrectAnswer}, NewEffectLog())
+
+	player := state.Players["P1"]
+	if player.Location != "R07" || !state.Rooms["R07"].Explored {
+		t.Errorf("expected P1 in explored R07, got %s", player.Location)
+	}
+	if state.PendingQuestion != nil {
+		t.Error("expected the pending question to be cleared")
+	}
+	if len(player.Hand) != 2 || player.Hand[1] != "SPECIAL_TEST" {
+		t.Errorf("expected the special card as a reward, hand %v", player.Hand)
+	}
+}
+
+func TestWrongAnswerMovesWithPenalties(t *testing.T) {
+	withTestQuestions(t)
+	state := Apply(newQuestionTestGameState(), MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+
+	state = Apply(state, scriptedAnswer(QuestionDB[3], "P1", false), NewEffectLog())
+
+	player := state.Players["P1"]
+	if player.Location != "R07" {
+		t.Errorf("expected P1 to move to R07 anyway, got %s", player.Location)
+	}
+	if len(player.Hand) != 0 || len(player.Discard) != 1 {
+		t.Errorf("expected the hand discarded, hand %v discard %v", player.Hand, player.Discard)
+	}
+	if state.Rooms["R07"].BugMarkers == 0 {
+		t.Error("expected bugs in R07 after a wrong answer")
+	}
+	if state.PendingQuestion != nil {
+		t.Error("expected the pending question to be cleared")
+	}
+}
+
+func TestExhaustedQuestionsMoveImmediately(t *testing.T) {
+	withTestQuestions(t)
+	state := newQuestionTestGameState()
+	state.NextQuestion = len(state.QuestionOrder)
+
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+
+	if state.PendingQuestion != nil || state.Players["P1"].Location != "R07" {
+		t.Errorf("expected a free move when no questions are left, got %s", state.Players["P1"].Location)
+	}
+}
+
+func TestPendingQuestionValidation(t *testing.T) {
+	withTestQuestions(t)
+	state := Apply(newQuestionTestGameState(), MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+
+	tests := []struct {
+		name   string
+		action Action
+		want   error
+	}{
+		{"other action", SearchAction{PlayerID: "P1"}, ErrQuestionPending},
+		{"pass", PassAction{PlayerID: "P1"}, ErrQuestionPending},
+		{"another move", MoveAction{PlayerID: "P1", To: "R11"}, ErrQuestionPending},
+		{"wrong player", AnswerQuestionAction{PlayerID: "P2", Choice: 0}, ErrNoPendingQuestion},
+		{"choice too high", AnswerQuestionAction{PlayerID: "P1", Choice: 4}, ErrInvalidChoice},
+		{"negative choice", AnswerQuestionAction{PlayerID: "P1", Choice: -1}, ErrInvalidChoice},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ApplyChecked(state, tt.action, NewEffectLog()); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	options := LegalActions(&state)
+	if len(options) != 4 {
+		t.Fatalf("expected only the 4 answers while a question is pending, got %d options", len(options))
+	}
+	for choice, option := range options {
+		if !reflect.DeepEqual(option.Action, AnswerQuestionAction{PlayerID: "P1", Choice: choice}) || !option.Legal() {
+			t.Errorf("option %d: expected a legal answer, got %#v (%s)", choice, option.Action, option.Reason())
+		}
+	}
+
+	if _, err := ApplyChecked(newQuestionTestGameState(), AnswerQuestionAction{PlayerID: "P1"}, NewEffectLog()); !errors.Is(err, ErrNoPendingQuestion) {
+		t.Errorf("expected ErrNoPendingQuestion without a pending move, got %v", err)
+	}
+}
+
+func TestPendingQuestionSurvivesSaveLoad(t *testing.T) {
+	withTestCardDB(t)
+	withTestQuestions(t)
+	state := Apply(newQuestionTestGameState(), MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+
+	data, err := SaveGameState(&state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, _, err := LoadSave(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.PendingQuestion == nil || *loaded.PendingQuestion != *state.PendingQuestion {
+		t.Errorf("expected pending question %+v after load, got %+v", state.PendingQuestion, loaded.PendingQuestion)
+	}
+
+	// Copies must not share the pending question
+	copied := DeepCopyGameState(state)
+	copied.PendingQuestion.To = "R11"
+	if state.PendingQuestion.To != "R07" {
+		t.Error("DeepCopyGameState shared the pending question")
+	}
+}
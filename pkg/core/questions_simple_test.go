@@ -5,6 +5,7 @@ import (
 )
 
 func TestGetRandomQuestion_ReturnsValidQuestion(t *testing.T) {
+	withTestQuestions(t)
 	state := initializeGameState(42, Frontend)
 	question, _ := GetRandomQuestion(state)
 	
@@ -23,6 +24,7 @@ func TestGetRandomQuestion_ReturnsValidQuestion(t *testing.T) {
 }
 
 func TestGetRandomQuestion_AdvancesCounter(t *testing.T) {
+	withTestQuestions(t)
 	state := initializeGameState(42, Frontend)
 	
 	// Initial state
@@ -49,6 +51,7 @@ func TestGetRandomQuestion_AdvancesCounter(t *testing.T) {
 }
 
 func TestCheckAnswer_CorrectAnswer(t *testing.T) {
+	withTestQuestions(t)
 	state := initializeGameState(42, Frontend)
 	question, _ := GetRandomQuestion(state)
 	
@@ -59,6 +62,7 @@ func TestCheckAnswer_CorrectAnswer(t *testing.T) {
 }
 
 func TestCheckAnswer_WrongAnswer(t *testing.T) {
+	withTestQuestions(t)
 	state := initializeGameState(42, Frontend)
 	question, _ := GetRandomQuestion(state)
 	
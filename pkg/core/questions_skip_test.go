@@ -0,0 +1,78 @@
+package core
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSkipQuestionEffectGrantsSkips(t *testing.T) {
+	state := checkedTestState()
+	card := Card{ID: "SKIP", Source: SrcAction, Effects: []Effect{{Op: SkipQuestion, Scope: Self, N: 2}}}
+	if err := ValidateCard(card); err != nil {
+		t.Fatalf("expected SkipQuestion to be a valid action card effect: %v", err)
+	}
+	if err := ValidateEffect(Effect{Op: SkipQuestion, Scope: AllPlayers, N: 1}, SrcAction); err == nil {
+		t.Error("expected SkipQuestion to be Self only")
+	}
+	if err := ValidateEffect(Effect{Op: SkipQuestion, Scope: Self, N: 1}, SrcEvent); err == nil {
+		t.Error("expected SkipQuestion to be rejected on event cards")
+	}
+
+	for _, effect := range card.Effects {
+		if err := ApplyEffect(&state, effect, "P1", NewEffectLog()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if skips := state.Players["P1"].QuestionSkips; skips != 2 {
+		t.Errorf("expected 2 question skips, got %d", skips)
+	}
+	if op, err := stringToEffectOp("SkipQuestion"); err != nil || op != SkipQuestion {
+		t.Errorf("expected the SkipQuestion op in the YAML vocabulary, got %v, %v", op, err)
+	}
+}
+
+func TestSkipQuestionMovesWithoutRewardOrPenalty(t *testing.T) {
+	withTestQuestions(t)
+	state := newQuestionTestGameState()
+	state.Players["P1"].QuestionSkips = 1
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+
+	options := LegalActions(&state)
+	if last := options[len(options)-1]; last.Action != (SkipQuestionAction{PlayerID: "P1"}) || !last.Legal() {
+		t.Errorf("expected a legal skip option, got %+v", last)
+	}
+
+	state, err := ApplyChecked(state, SkipQuestionAction{PlayerID: "P1"}, NewEffectLog())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	player := state.Players["P1"]
+	if player.Location != "R07" || state.PendingQuestion != nil {
+		t.Errorf("expected P1 to move to R07, got %s", player.Location)
+	}
+	if player.QuestionSkips != 0 || len(player.Hand) != 1 {
+		t.Errorf("expected one skip spent and the hand kept without a reward, got skips %d hand %v",
+			player.QuestionSkips, player.Hand)
+	}
+	if len(state.AnswerHistory) != 0 {
+		t.Errorf("expected a skipped question not to count as answered, got %v", state.AnswerHistory)
+	}
+}
+
+func TestSkipQuestionValidation(t *testing.T) {
+	withTestQuestions(t)
+	state := newQuestionTestGameState()
+	if err := ValidateAction(&state, SkipQuestionAction{PlayerID: "P1"}); !errors.Is(err, ErrNoPendingQuestion) {
+		t.Errorf("expected ErrNoPendingQuestion without a question, got %v", err)
+	}
+
+	state = Apply(state, MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+	if err := ValidateAction(&state, SkipQuestionAction{PlayerID: "P1"}); !errors.Is(err, ErrNoQuestionSkips) {
+		t.Errorf("expected ErrNoQuestionSkips without skips, got %v", err)
+	}
+	for _, option := range LegalActions(&state) {
+		if _, isSkip := option.Action.(SkipQuestionAction); isSkip {
+			t.Error("expected no skip option without skips")
+		}
+	}
+}
@@ -1,126 +1,97 @@
 package core
 
 import (
+	"fmt"
 	"math/rand"
+	"strings"
 )
 
 func Apply(state GameState, action Action, log *EffectLog) GameState {
 	switch a := action.(type) {
 	case InitializeGameAction:
 		// Create initial game state - no deep copy needed
-		return initializeGameState(a.Seed, a.PlayerClass)
+		classes := a.PlayerClasses
+		if len(classes) == 0 {
+			classes = []DevClass{a.PlayerClass}
+		}
+		state := initializeGameState(a.Seed, classes...)
+		if a.Difficulty != "" {
+			state.Difficulty = a.Difficulty
+			state.SpawnBag = initializeSpawnBag(a.Difficulty)
+		}
+		return state
 
 	case MoveAction:
 		// Deep copy the state to avoid mutations
 		newState := deepCopyGameState(state)
 		player, exists := newState.Players[a.PlayerID]
-		if !exists {
+		if !exists || newState.PendingQuestion != nil {
 			return newState
 		}
 		
 		// Validate move using CanMove
-		if CanMove(&newState, player.Location, a.To) {
-			oldLocation := player.Location
-			player.Location = a.To
-			log.Add("🚶 %s moves from %s → %s", a.PlayerID, oldLocation, a.To)
-			
-			// Mark target room as explored when entering
-			if room := newState.Rooms[a.To]; room != nil && !room.Explored {
-				room.Explored = true
-				log.Add("🏷️  %s marked as explored", a.To)
-			}
-			
-			// Movement consequence: RNG bug placement (3 equal outcomes)
-			rng := rand.New(rand.NewSource(newState.RandSeed + int64(newState.Round)*1000 + int64(len(newState.Players))))
-			bugOutcome := rng.Intn(3) // 0, 1, or 2
-			
-			switch bugOutcome {
-			case 0:
-				// 1 bug in current room (where player moved FROM)
-				log.Add("⚠️ Movement consequence: Bug left behind in departure room")
-				if oldRoom := newState.Rooms[oldLocation]; oldRoom != nil {
-					oldBugs := oldRoom.BugMarkers
-					oldRoom.BugMarkers += 1
-					if oldRoom.BugMarkers > MaxBugMarkers {
-						oldRoom.BugMarkers = MaxBugMarkers
-					}
-					log.Add("🪲 %s bugs: %d → %d (left behind)", oldLocation, oldBugs, oldRoom.BugMarkers)
-				}
-			case 1:
-				// 1 bug in max 2 surrounding rooms of old location
-				log.Add("⚠️ Movement consequence: Bugs spread to adjacent rooms")
-				adjacentRooms := GetAdjacentRooms(oldLocation)
-				if len(adjacentRooms) > 0 {
-					// Shuffle adjacent rooms and pick max 2
-					shuffledRooms := make([]RoomID, len(adjacentRooms))
-					copy(shuffledRooms, adjacentRooms)
-					shuffleRooms(shuffledRooms, rng)
-					
-					maxRooms := 2
-					if len(shuffledRooms) < maxRooms {
-						maxRooms = len(shuffledRooms)
-					}
-					
-					bugsSpread := 0
-					for i := 0; i < maxRooms; i++ {
-						if room := newState.Rooms[shuffledRooms[i]]; room != nil {
-							oldBugs := room.BugMarkers
-							room.BugMarkers += 1
-							if room.BugMarkers > MaxBugMarkers {
-								room.BugMarkers = MaxBugMarkers
-							}
-							log.Add("🪲 %s bugs: %d → %d (spread from %s)", shuffledRooms[i], oldBugs, room.BugMarkers, oldLocation)
-							bugsSpread++
-						}
-					}
-					if bugsSpread > 0 {
-						log.Add("📡 %d adjacent room(s) affected by movement", bugsSpread)
-					}
-				}
-			case 2:
-				// Safe - no bugs added
-				log.Add("✅ Movement consequence: Safe passage (no bugs added)")
+		if !CanMove(&newState, player.Location, a.To) {
+			return newState
+		}
+		
+		// Entering a corrupted room costs an extra action or a question at the door
+		tax := CorruptionTaxFor(&newState, a)
+		if tax == ExtraActionTax {
+			log.Add("💀 %s is corrupted: entering it costs an extra action", a.To)
+		}
+		
+		// Entering an unexplored room waits on a coding question while any are left
+		if room := newState.Rooms[a.To]; room != nil && !room.Explored && a.To != player.Location {
+			if askQuestion(&newState, a, log) {
+				return newState
 			}
 		}
+		
+		if tax == QuestionTax && askQuestion(&newState, a, log) {
+			newState.PendingQuestion.Corrupted = true
+			log.Add("💀 %s is corrupted: answer correctly or stay out", a.To)
+			return newState
+		}
+		
+		applyMove(&newState, player, a.To, log)
 		return newState
 
-	case GiveSpecialCardAction:
+	case OpenDoorAction:
 		// Deep copy the state to avoid mutations
 		newState := deepCopyGameState(state)
-		player, exists := newState.Players[a.PlayerID]
-		if !exists {
-			return newState
+		if player, exists := newState.Players[a.PlayerID]; exists {
+			playerOpensDoor(&newState, player, a.To, log)
 		}
+		return newState
 
-		// Use game RNG to select random special card
-		rng := rand.New(rand.NewSource(newState.RandSeed + int64(newState.Round)*500 + int64(len(newState.Players))))
-		
-		// Collect all special card IDs
-		specialCards := make([]CardID, 0)
-		for cardID, card := range CardDB {
-			if card.Source == SrcSpecial {
-				specialCards = append(specialCards, cardID)
-			}
-		}
-		
-		if len(specialCards) > 0 {
-			// Pick random special card
-			cardIndex := rng.Intn(len(specialCards))
-			selectedCard := specialCards[cardIndex]
-			
-			// Add to hand
-			player.Hand = append(player.Hand, selectedCard)
-			
-			// Enforce hand limit
-			enforceHandLimitWithDiscard(&player.Hand, &player.Discard)
+	case AnswerQuestionAction:
+		// Deep copy the state to avoid mutations
+		newState := deepCopyGameState(state)
+		resolveQuestion(&newState, a, log)
+		return newState
+
+	case SkipQuestionAction:
+		// Deep copy the state to avoid mutations
+		newState := deepCopyGameState(state)
+		skipQuestion(&newState, a, log)
+		return newState
+
+	case GiveSpecialCardAction:
+		// Deep copy the state to avoid mutations
+		newState := deepCopyGameState(state)
+		if player, exists := newState.Players[a.PlayerID]; exists {
+			giveSpecialCard(&newState, player)
 		}
-		
 		return newState
 		
 	case SearchAction:
-		// Use the proper search logic with RNG
-		rng := rand.New(rand.NewSource(state.RandSeed + int64(state.Round)*1000))
-		return ApplySearch(state, a, rng, log)
+		// Use the proper search logic with RNG. ApplySearch copies the state
+		// again, so carry the advanced stream over to its result.
+		newState := deepCopyGameState(state)
+		rng := GetGameRNG(&newState)
+		result := ApplySearch(newState, a, rng, log)
+		result.RNGState = newState.RNGState
+		return result
 
 	case PlayCardAction:
 		// Deep copy the state to avoid mutations
@@ -156,22 +127,29 @@ func Apply(state GameState, action Action, log *EffectLog) GameState {
 
 		// Check for engine card usage at escape room
 		if a.CardID == "SPECIAL_ENGINE" {
-			if player.Location == "R19" || player.Location == "R20" {
-				// Check if no Pythogoras in escape room
-				pythogorasInEscapeRoom := false
+			if RoleOf(player.Location) == EscapeRole {
+				// Check no escape-blocking enemy (Pythogoras) is in an escape room
+				escapeBlocked := false
 				for _, enemy := range newState.Enemies {
-					if enemy.Type == Pythogoras && (enemy.Location == "R19" || enemy.Location == "R20") {
-						pythogorasInEscapeRoom = true
+					if enemyDef(enemy.Type).BlocksEscape && RoleOf(enemy.Location) == EscapeRole {
+						escapeBlocked = true
 						break
 					}
 				}
-				if !pythogorasInEscapeRoom {
+				if !escapeBlocked {
 					player.EngineUsed = true // Mark as victory condition met
 					log.Add("🚀 Engine activated! Victory condition met")
 				}
 			}
 		}
 
+		// Movement cards walk the path to the chosen room (see ApplyMoveSelf)
+		if card, exists := CardDB[a.CardID]; exists && a.Target != "" {
+			if path := PlanSprint(&newState, a.PlayerID, a.Target, SprintSteps(card)); path.Valid {
+				newState.Sprint = path.Path[1:]
+			}
+		}
+
 		// Apply card effects using the effects engine
 		if card, exists := CardDB[a.CardID]; exists {
 			newState = ApplyCardEffects(newState, card, a.PlayerID, log)
@@ -186,12 +164,126 @@ func Apply(state GameState, action Action, log *EffectLog) GameState {
 	case RoomAction:
 		// Handle room-specific actions
 		return ApplyRoomAction(state, a, log)
+
+	case PassAction:
+		// Passing forfeits the rest of the active player's actions
+		newState := deepCopyGameState(state)
+		if _, exists := newState.Players[a.PlayerID]; !exists {
+			return newState
+		}
+		log.Add("⏭️ %s passes (%d actions skipped)", a.PlayerID, newState.ActionsLeft)
+		newState.ActionsLeft = 0
+		return newState
 }
 	
 	// Default case - return original state unchanged
 	return state
 }
 
+// applyMove moves a player into an adjacent room, marks it explored and rolls
+// the movement consequence that may leave bugs behind.
+func applyMove(state *GameState, player *PlayerState, to RoomID, log *EffectLog) {
+	oldLocation := player.Location
+	player.Location = to
+	log.Add("🚶 %s moves from %s → %s", player.ID, oldLocation, to)
+	makeNoise(state, to, ActionNoise.Move)
+	
+	// Mark target room as explored when entering
+	if room := state.Rooms[to]; room != nil && !room.Explored {
+		room.Explored = true
+		log.Add("🏷️  %s marked as explored", to)
+	}
+	
+	// Movement consequence: RNG bug placement (3 equal outcomes)
+	rng := GetGameRNG(state)
+	bugOutcome := rng.Intn(3) // 0, 1, or 2
+	
+	switch bugOutcome {
+	case 0:
+		// 1 bug in current room (where player moved FROM)
+		log.Add("⚠️ Movement consequence: Bug left behind in departure room")
+		if oldRoom := state.Rooms[oldLocation]; oldRoom != nil {
+			oldBugs := oldRoom.BugMarkers
+			oldRoom.BugMarkers += 1
+			if oldRoom.BugMarkers > MaxBugMarkers {
+				oldRoom.BugMarkers = MaxBugMarkers
+			}
+			log.Add("🪲 %s bugs: %d → %d (left behind)", oldLocation, oldBugs, oldRoom.BugMarkers)
+		}
+	case 1:
+		// 1 bug in max 2 surrounding rooms of old location
+		log.Add("⚠️ Movement consequence: Bugs spread to adjacent rooms")
+		adjacentRooms := GetAdjacentRooms(oldLocation)
+		if len(adjacentRooms) > 0 {
+			// Shuffle adjacent rooms and pick max 2
+			shuffledRooms := make([]RoomID, len(adjacentRooms))
+			copy(shuffledRooms, adjacentRooms)
+			shuffleRooms(shuffledRooms, rng)
+			
+			maxRooms := 2
+			if len(shuffledRooms) < maxRooms {
+				maxRooms = len(shuffledRooms)
+			}
+			
+			bugsSpread := 0
+			for i := 0; i < maxRooms; i++ {
+				if room := state.Rooms[shuffledRooms[i]]; room != nil {
+					oldBugs := room.BugMarkers
+					room.BugMarkers += 1
+					if room.BugMarkers > MaxBugMarkers {
+						room.BugMarkers = MaxBugMarkers
+					}
+					log.Add("🪲 %s bugs: %d → %d (spread from %s)", shuffledRooms[i], oldBugs, room.BugMarkers, oldLocation)
+					bugsSpread++
+				}
+			}
+			if bugsSpread > 0 {
+				log.Add("📡 %d adjacent room(s) affected by movement", bugsSpread)
+			}
+		}
+	case 2:
+		// Safe - no bugs added
+		log.Add("✅ Movement consequence: Safe passage (no bugs added)")
+	}
+}
+
+// giveSpecialCard deals a random special card into the player's hand and
+// returns it, or "" when no special cards are loaded.
+func giveSpecialCard(state *GameState, player *PlayerState) CardID {
+	return giveCardFrom(state, player, cardIDsBySource(SrcSpecial))
+}
+
+// giveRareSpecialCard gives a random rare special card, falling back to any
+// special card when none are marked rare
+func giveRareSpecialCard(state *GameState, player *PlayerState) CardID {
+	rareCards := []CardID{}
+	for _, id := range cardIDsBySource(SrcSpecial) {
+		if CardDB[id].Rarity == "rare" {
+			rareCards = append(rareCards, id)
+		}
+	}
+	if len(rareCards) == 0 {
+		return giveSpecialCard(state, player)
+	}
+	return giveCardFrom(state, player, rareCards)
+}
+
+// giveCardFrom adds a random card from specialCards to the player's hand
+func giveCardFrom(state *GameState, player *PlayerState, specialCards []CardID) CardID {
+	if len(specialCards) == 0 {
+		return ""
+	}
+	
+	// Use game RNG to select random special card
+	rng := GetGameRNG(state)
+	selectedCard := specialCards[rng.Intn(len(specialCards))]
+	
+	// Add to hand and enforce hand limit
+	player.Hand = append(player.Hand, selectedCard)
+	enforceHandLimitWithDiscard(&player.Hand, &player.Discard)
+	return selectedCard
+}
+
 // ApplyWithoutLog is a convenience wrapper for backwards compatibility
 func ApplyWithoutLog(state GameState, action Action) GameState {
 	log := NewEffectLog()
@@ -209,6 +301,8 @@ func deepCopyGameState(state GameState) GameState {
 		Round:         state.Round,
 		Time:          state.Time,
 		RandSeed:      state.RandSeed,
+		RNGState:      state.RNGState,
+		Difficulty:    state.Difficulty,
 		EventIndex:    state.EventIndex,
 		ActionsLeft:   state.ActionsLeft,
 		Phase:         state.Phase,
@@ -218,6 +312,7 @@ func deepCopyGameState(state GameState) GameState {
 		Events:        make([]EventCard, len(state.Events)),
 		SpawnBag:      nil,
 		Enemies:       make(map[EnemyID]*Enemy),
+		Map:           state.Map,
 		QuestionOrder: make([]int, len(state.QuestionOrder)),
 		NextQuestion:  state.NextQuestion,
 		ScratchLog:    NewEffectLog(), // Initialize effect log
@@ -255,6 +350,13 @@ func deepCopyGameState(state GameState) GameState {
 			EngineUsed:   player.EngineUsed,
 			PersonalObj:  player.PersonalObj,
 			CorporateObj: player.CorporateObj,
+			QuestionSkips: player.QuestionSkips,
+		}
+		if player.Kills != nil {
+			newState.Players[id].Kills = make(map[EnemyType]int, len(player.Kills))
+			for enemyType, count := range player.Kills {
+				newState.Players[id].Kills[enemyType] = count
+			}
 		}
 		copy(newState.Players[id].Hand, player.Hand)
 		copy(newState.Players[id].Deck, player.Deck)
@@ -264,6 +366,28 @@ func deepCopyGameState(state GameState) GameState {
 	// Copy events and question order
 	copy(newState.Events, state.Events)
 	copy(newState.QuestionOrder, state.QuestionOrder)
+	if state.PendingQuestion != nil {
+		pending := *state.PendingQuestion
+		newState.PendingQuestion = &pending
+	}
+	if state.AnswerHistory != nil {
+		newState.AnswerHistory = append([]AnsweredQuestion(nil), state.AnswerHistory...)
+	}
+	if state.Sprint != nil {
+		newState.Sprint = append([]RoomID(nil), state.Sprint...)
+	}
+	if state.Doors != nil {
+		newState.Doors = make(map[Edge]DoorState, len(state.Doors))
+		for edge, door := range state.Doors {
+			newState.Doors[edge] = door
+		}
+	}
+	if state.Noise != nil {
+		newState.Noise = make(map[RoomID]int, len(state.Noise))
+		for room, noise := range state.Noise {
+			newState.Noise[room] = noise
+		}
+	}
 	
 	// Deep copy spawn bag
 	if state.SpawnBag != nil {
@@ -282,57 +406,71 @@ func deepCopyGameState(state GameState) GameState {
 			MaxHP:    enemy.MaxHP,
 			Damage:   enemy.Damage,
 			Location: enemy.Location,
+			Rounds:   enemy.Rounds,
 		}
 	}
 	
 	return newState
 }
 
-// initializeGameState creates a fresh game state
-func initializeGameState(seed int64, playerClass DevClass) GameState {
+// initializeGameState creates a fresh game state with one player per class (P1, P2, ...)
+func initializeGameState(seed int64, playerClasses ...DevClass) GameState {
+	if len(playerClasses) == 0 {
+		playerClasses = []DevClass{Frontend}
+	}
+	if len(playerClasses) > MaxPlayers {
+		playerClasses = playerClasses[:MaxPlayers]
+	}
+
 	state := GameState{
 		Round:         1,
 		Time:          15, // Start with 15 time units
 		RandSeed:      seed,
+		RNGState:      seedRNGState(seed),
+		Difficulty:    Normal,
 		EventIndex:    0,
 		Rooms:         make(map[RoomID]*RoomState),
 		Players:       make(map[PlayerID]*PlayerState),
 		Events:        initializeEventCards(),
-		SpawnBag:      initializeSpawnBag(),
+		SpawnBag:      initializeSpawnBag(Normal),
 		Enemies:       make(map[EnemyID]*Enemy),
-		// Initialize pre-shuffled question order
-		QuestionOrder: initializeQuestionOrder(seed),
 		NextQuestion:  0,
 		ScratchLog:    NewEffectLog(), // Initialize effect log
 	}
 
-	// Initialize all 20 rooms from the spaceship layout
-	// Create room type distribution for non-predefined rooms
-	roomTypePool := []RoomType{
-		AmmoCache, AmmoCache, AmmoCache,        // 3 AmmoCache
-		MedBay, MedBay, MedBay,                 // 3 MedBay  
-		CleanRoomType,                          // 1 CleanRoomType
-		EnemySpawn, EnemySpawn, EnemySpawn, EnemySpawn, // 4 EnemySpawn
-		Empty, Empty,                           // 2 Empty
+	// Initialize the rooms from the current map; rooms without a fixed role
+	// are dealt a type from the map's pool
+	shipMap := CurrentMap
+	if shipMap == nil {
+		shipMap = &ShipMap{}
 	}
+	state.Map = shipMap.Name
+	for edge, door := range shipMap.Doors {
+		setDoor(&state, edge, door)
+	}
+	roomTypePool := append([]RoomType(nil), shipMap.TypePool...)
 	
-	// Use game RNG for consistent room assignment
-	tempState := GameState{RandSeed: seed, Round: 1, Time: 15}
-	rng := GetGameRNG(&tempState)
+	// All setup randomness comes from the game's stream
+	rng := GetGameRNG(&state)
+
+	// Initialize pre-shuffled question order
+	state.QuestionOrder = initializeQuestionOrder(rng)
 	
 	// Shuffle the room type pool for random assignment
 	shuffleRoomTypes(roomTypePool, rng)
 	
+	// Assign types in room ID order so the shuffled pool maps to the same rooms every run
 	poolIndex := 0
-	for roomIDStr := range ROOM_POSITIONS {
-		roomID := RoomID(roomIDStr)
+	for _, roomID := range shipMap.sortedRoomIDs() {
 		roomType := Empty
 		explored := false
 
-		// Check if this room has a predefined type
-		if predefinedType, exists := PREDEFINED_ROOMS[roomIDStr]; exists {
-			roomType = predefinedType
-			explored = true // Predefined rooms are already explored
+		// Rooms with a role (start, key, engine, escape) are predefined.
+		// The key and engine rooms are left unexplored so their harder
+		// questions (see QuestionDifficultyFor) guard the way in.
+		if role := shipMap.roleOf(roomID); role != NoRole {
+			roomType = Predefined
+			explored = !role.highValue()
 		} else {
 			// Assign from shuffled pool
 			roomType = roomTypePool[poolIndex]
@@ -350,31 +488,36 @@ func initializeGameState(seed int64, playerClass DevClass) GameState {
 		}
 	}
 
-	// Initialize single player with class-specific stats
-	playerID := PlayerID("P1")
-	classStats := CLASS_STATS[playerClass]
-
-	state.Players[playerID] = &PlayerState{
-		ID:           playerID,
-		Class:        playerClass,
-		HP:           classStats.HP,
-		MaxHP:        classStats.HP,
-		Ammo:         classStats.MaxAmmo,
-		MaxAmmo:      classStats.MaxAmmo,
-		Damage:       BasicDamage, // Base damage
-		Hand:         []CardID{},
-		Deck:         createRandomStartingDeck(seed),
-		Discard:      []CardID{},
-		Location:     "R12", // Start room
-		HasActed:     false,
-		SpecialUsed:  false,
-		EngineUsed:   false,
-		PersonalObj:  ObjectiveID(""),
-		CorporateObj: ObjectiveID(""),
-	}
-
-	// Set turn controller state
-	state.ActivePlayer = playerID  // "P1" for solo mode
+	// Initialize players with class-specific stats, all starting in the start room
+	for i, playerClass := range playerClasses {
+		playerID := PlayerID(fmt.Sprintf("P%d", i+1))
+		classStats := CLASS_STATS[playerClass]
+
+		state.Players[playerID] = &PlayerState{
+			ID:           playerID,
+			Class:        playerClass,
+			HP:           classStats.HP,
+			MaxHP:        classStats.HP,
+			Ammo:         classStats.MaxAmmo,
+			MaxAmmo:      classStats.MaxAmmo,
+			Damage:       BasicDamage, // Base damage
+			Hand:         []CardID{},
+			Deck:         createRandomStartingDeck(rng),
+			Discard:      []CardID{},
+			Location:     shipMap.Start,
+			HasActed:     false,
+			SpecialUsed:  false,
+			EngineUsed:   false,
+			PersonalObj:  ObjectiveID(""),
+			CorporateObj: ObjectiveID(""),
+		}
+	}
+
+	// Deal personal and corporate objectives from the loaded catalogue
+	dealObjectives(&state, rng)
+
+	// Set turn controller state - P1 always opens the round
+	state.ActivePlayer = PlayerID("P1")
 	state.Phase = "player"
 	state.ActionsLeft = 0 // Will be set by DrawPhase
 
@@ -454,6 +597,16 @@ func GetAvailableClasses() []ClassOption {
 	}
 }
 
+// ParseDevClass converts a class name such as "backend" to DevClass
+func ParseDevClass(name string) (DevClass, error) {
+	for _, class := range GetAvailableClasses() {
+		if strings.EqualFold(class.DisplayName, name) {
+			return class.Class, nil
+		}
+	}
+	return Frontend, fmt.Errorf("unknown class %q (use frontend, backend, devops or fullstack)", name)
+}
+
 // ValidateClassChoice validates a class selection ID and returns the DevClass
 func ValidateClassChoice(choiceID int) (DevClass, bool) {
 	classes := GetAvailableClasses()
@@ -468,63 +621,65 @@ func ValidateClassChoice(choiceID int) (DevClass, bool) {
 // initializeEventCards loads all event cards from the CardDB
 func initializeEventCards() []EventCard {
 	eventCards := make([]EventCard, 0)
-	for cardID, card := range CardDB {
-		if card.Source == SrcEvent {
-			eventCards = append(eventCards, EventCard{
-				ID:          cardID,
-				Name:        card.Name,
-				Description: card.Description,
-				Effects:     card.Effects,
-			})
-		}
+	for _, cardID := range cardIDsBySource(SrcEvent) {
+		card := CardDB[cardID]
+		eventCards = append(eventCards, EventCard{
+			ID:          cardID,
+			Name:        card.Name,
+			Description: card.Description,
+			Effects:     card.Effects,
+		})
 	}
 	return eventCards
 }
 
-// initializeSpawnBag creates the initial enemy spawn pool
-func initializeSpawnBag() *SpawnBag {
+// initializeSpawnBag creates the initial enemy spawn pool for a difficulty
+func initializeSpawnBag(difficulty Difficulty) *SpawnBag {
 	bag := &SpawnBag{
 		Tokens: []EnemyType{},
 	}
 
-	// Add enemy tokens based on difficulty distribution
-	// More weak enemies, fewer strong ones
-
-	// 10 Infinite Loops (weakest)
-	for i := 0; i < 10; i++ {
-		bag.Tokens = append(bag.Tokens, InfiniteLoop)
+	switch difficulty {
+	case Easy, Normal, Hard:
+	default:
+		difficulty = Normal
 	}
 
-	// 6 Stack Overflows (medium)
-	for i := 0; i < 6; i++ {
-		bag.Tokens = append(bag.Tokens, StackOverflow)
-	}
-
-	// 2 Pythogoras (strongest)
-	for i := 0; i < 2; i++ {
-		bag.Tokens = append(bag.Tokens, Pythogoras)
+	// Add tokens in type order so the bag order is stable
+	for _, enemyType := range EnemyTypes() {
+		for i := 0; i < EnemyDB[enemyType].SpawnBag[difficulty]; i++ {
+			bag.Tokens = append(bag.Tokens, enemyType)
+		}
 	}
 
 	return bag
 }
 
-// initializeQuestionOrder creates a pre-shuffled order of question IDs 0-49
-func initializeQuestionOrder(seed int64) []int {
-	rng := rand.New(rand.NewSource(seed))
-	return rng.Perm(50) // Creates [0,1,2,...,49] in random order
+// ParseDifficulty converts a command-line difficulty name to Difficulty
+func ParseDifficulty(s string) (Difficulty, error) {
+	switch difficulty := Difficulty(strings.ToLower(s)); difficulty {
+	case Easy, Normal, Hard:
+		return difficulty, nil
+	default:
+		return "", fmt.Errorf("unknown difficulty %q (use easy, normal or hard)", s)
+	}
+}
+
+// initializeQuestionOrder creates a pre-shuffled order of the loaded question IDs
+func initializeQuestionOrder(rng *rand.Rand) []int {
+	ids := sortedQuestionIDs()
+	order := make([]int, len(ids))
+	for i, j := range rng.Perm(len(ids)) {
+		order[i] = ids[j]
+	}
+	return order
 }
 
 // createRandomStartingDeck creates a random 10-card starting deck from action cards
-func createRandomStartingDeck(seed int64) []CardID {
-	rng := rand.New(rand.NewSource(seed + 1000)) // Offset seed for deck generation
-	
+func createRandomStartingDeck(rng *rand.Rand) []CardID {
+
 	// Get all action card IDs from the loaded CardDB
-	actionCards := make([]CardID, 0)
-	for cardID, card := range CardDB {
-		if card.Source == SrcAction {
-			actionCards = append(actionCards, cardID)
-		}
-	}
+	actionCards := cardIDsBySource(SrcAction)
 	
 	// If no cards loaded, return empty deck (fallback)
 	if len(actionCards) == 0 {
@@ -0,0 +1,39 @@
+package core
+
+import "math/rand"
+
+// gameSource is a SplitMix64 generator whose entire state lives in
+// GameState.RNGState, so the random stream is saved, loaded and copied
+// along with the rest of the game.
+type gameSource struct {
+	state *uint64
+}
+
+func (s gameSource) Uint64() uint64 {
+	*s.state += 0x9e3779b97f4a7c15
+	z := *s.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+func (s gameSource) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+func (s gameSource) Seed(seed int64) {
+	*s.state = seedRNGState(seed)
+}
+
+// seedRNGState derives the initial stream state from a game seed
+func seedRNGState(seed int64) uint64 {
+	state := uint64(seed)
+	return gameSource{state: &state}.Uint64()
+}
+
+// GetGameRNG returns a generator that draws from and advances the state's
+// random stream. Every random decision in the engine must go through it so
+// outcomes are independent yet reproducible from a save.
+func GetGameRNG(state *GameState) *rand.Rand {
+	return rand.New(gameSource{state: &state.RNGState})
+}
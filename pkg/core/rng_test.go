@@ -0,0 +1,85 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestMoveActionAdvancesRNGState(t *testing.T) {
+	state := newTestGameState()
+	state.RNGState = seedRNGState(state.RandSeed)
+
+	moved := Apply(state, MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+	if moved.RNGState == state.RNGState {
+		t.Fatal("MoveAction should advance the game's random stream")
+	}
+
+	// The same state must reproduce the same outcome
+	again := Apply(state, MoveAction{PlayerID: "P1", To: "R07"}, NewEffectLog())
+	if again.RNGState != moved.RNGState || again.Rooms["R12"].BugMarkers != moved.Rooms["R12"].BugMarkers {
+		t.Error("MoveAction should be reproducible from the same state")
+	}
+}
+
+func TestSuccessiveMovesDrawIndependentOutcomes(t *testing.T) {
+	state := newTestGameState()
+	state.RNGState = seedRNGState(state.RandSeed)
+	state.Rooms["R12"].Explored = true
+	state.Rooms["R07"].Explored = true
+
+	moves := []MoveAction{{PlayerID: "P1", To: "R07"}, {PlayerID: "P1", To: "R12"}}
+	play := func() []GameState {
+		states := []GameState{state}
+		for _, move := range moves {
+			states = append(states, Apply(states[len(states)-1], move, NewEffectLog()))
+		}
+		return states
+	}
+
+	// With per-round seeds every move in a round used to roll the same
+	// outcome; each move must take the next draw from the stream instead
+	played := play()
+	expected := state
+	for i, move := range moves {
+		GetGameRNG(&expected).Intn(3)
+		if played[i+1].RNGState == played[i].RNGState {
+			t.Errorf("move %d to %s did not advance the random stream", i+1, move.To)
+		}
+		if played[i+1].RNGState != expected.RNGState {
+			t.Errorf("move %d to %s should take exactly the next draw from the stream", i+1, move.To)
+		}
+	}
+
+	// Replaying the moves from the same seed reproduces every draw
+	for i, replayed := range play() {
+		if replayed.RNGState != played[i].RNGState {
+			t.Errorf("replay diverged from the random stream after move %d", i)
+		}
+		for _, id := range []RoomID{"R12", "R07"} {
+			if replayed.Rooms[id].BugMarkers != played[i].Rooms[id].BugMarkers {
+				t.Errorf("replay left %d bugs in %s after move %d, expected %d", replayed.Rooms[id].BugMarkers, id, i, played[i].Rooms[id].BugMarkers)
+			}
+		}
+	}
+}
+
+func TestSearchActionCarriesRNGState(t *testing.T) {
+	state := newSearchTestGameState()
+	state.RNGState = seedRNGState(state.RandSeed)
+
+	result := Apply(state, SearchAction{PlayerID: "P1"}, NewEffectLog())
+	if result.RNGState == state.RNGState {
+		t.Error("SearchAction should return the advanced random stream")
+	}
+}
+
+func TestInitializeGameStateSeedsRNGState(t *testing.T) {
+	state1 := initializeGameState(42, Frontend, Backend)
+	state2 := initializeGameState(42, Frontend, Backend)
+
+	if state1.RNGState != state2.RNGState {
+		t.Error("same seed should leave the stream in the same place after setup")
+	}
+	if state1.RNGState == seedRNGState(42) {
+		t.Error("setup should draw from the game's random stream")
+	}
+}
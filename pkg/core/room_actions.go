@@ -36,6 +36,9 @@ func ApplyRoomAction(state GameState, action RoomAction, log *EffectLog) GameSta
 		return state // Return original state unchanged
 	}
 	
+	if player.SpecialUsed {
+		makeNoise(&newState, player.Location, ActionNoise.Room)
+	}
 	return newState
 }
 
@@ -30,17 +30,18 @@ func ApplySearch(state GameState, action SearchAction, rng *rand.Rand, log *Effe
 	// Mark room as searched (free action, no card cost)
 	room.Searched = true
 	log.Add("🔍 %s searches %s", action.PlayerID, player.Location)
+	makeNoise(&newState, player.Location, ActionNoise.Search)
 	
 	// Room-specific search overrides
-	switch player.Location {
-	case "R01": // KEY room - gives BOOT.dev KEY power
+	switch RoleOf(player.Location) {
+	case KeyRole: // KEY room - gives BOOT.dev KEY power
 		oldDamage := player.Damage
 		player.Damage = BootDevDamage // Increase damage from BasicDamage to BootDevDamage
 		log.Add("🔑 Found the BOOT.dev KEY! Damage: %d → %d", oldDamage, player.Damage)
 		// Note: This is a permanent power upgrade, not a card
 		return newState
 		
-	case "R15", "R17", "R18": // Engine rooms EN1, EN2, EN3
+	case EngineRole: // Engine rooms EN1, EN2, EN3
 		// Give 3 engine cards (representing all 3 engines)
 		player.Hand = append(player.Hand, "SPECIAL_ENGINE", "SPECIAL_ENGINE", "SPECIAL_ENGINE")
 		log.Add("⚙️ Found 3 engine cards!")
@@ -76,7 +77,8 @@ func ApplySearch(state GameState, action SearchAction, rng *rand.Rand, log *Effe
 func selectRandomSpecialCard(rng *rand.Rand) CardID {
 	// Collect all special card IDs from the database
 	var specialCards []CardID
-	for cardID, card := range CardDB {
+	for _, cardID := range sortedCardIDs() {
+		card := CardDB[cardID]
 		// Check if it's a special card by ID prefix
 		if strings.HasPrefix(string(cardID), "SPECIAL") {
 			// Get rarity from card data
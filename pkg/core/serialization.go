@@ -1,25 +1,285 @@
 package core
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strconv"
 )
 
-// SaveGameState serializes game state to JSON
+// CurrentSaveVersion is the schema version written by SaveGameState.
+//
+// Version history:
+//   1 - bare GameState JSON with full event card effects embedded
+//   2 - versioned envelope; events stored by card ID and rehydrated from CardDB
+//   3 - RNGState holds the game's random stream
+//   4 - QuestionOrder holds question IDs from the data files rather than the
+//       built-in bank; adds pending questions, answer history, question skips,
+//       the map, doors, sprints, enemy ages and noise
+const CurrentSaveVersion = 4
+
+// SaveEnvelope wraps a serialized GameState with format metadata
+type SaveEnvelope struct {
+	Version    int             `json:"version"`
+	CardDBHash string          `json:"card_db_hash"`
+	State      json.RawMessage `json:"state"`
+}
+
+// SaveInfo describes what happened while loading a save
+type SaveInfo struct {
+	Version       int  // Version the save was written with
+	Migrated      bool // Save was upgraded from an older version
+	CardDBChanged bool // cards.yaml changed since the save was written
+}
+
+// SaveMigrationError reports a save that cannot be upgraded to the current format
+type SaveMigrationError struct {
+	FromVersion int
+	ToVersion   int
+	Reason      string
+}
+
+func (e *SaveMigrationError) Error() string {
+	return fmt.Sprintf("cannot migrate save from version %d to %d: %s", e.FromVersion, e.ToVersion, e.Reason)
+}
+
+// saveMigration upgrades a decoded state document by exactly one version
+type saveMigration func(doc map[string]any) error
+
+// saveMigrations maps a version to the step that upgrades it to version+1
+var saveMigrations = map[int]saveMigration{
+	1: migrateSaveV1ToV2,
+	2: migrateSaveV2ToV3,
+	3: migrateSaveV3ToV4,
+}
+
+// SaveGameState serializes game state into a versioned envelope
 func SaveGameState(state *GameState) ([]byte, error) {
-	data, err := json.MarshalIndent(state, "", "  ")
+	stateData, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal game state: %w", err)
+	}
+
+	// Events are stored by ID only so card changes are picked up on load
+	doc, err := decodeStateDocument(stateData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal game state: %w", err)
+	}
+	stripEventEffects(doc)
+
+	envelope := SaveEnvelope{
+		Version:    CurrentSaveVersion,
+		CardDBHash: CardDBHash(),
+	}
+	if envelope.State, err = json.Marshal(doc); err != nil {
+		return nil, fmt.Errorf("failed to marshal game state: %w", err)
+	}
+
+	data, err := json.MarshalIndent(envelope, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal game state: %w", err)
 	}
 	return data, nil
 }
 
-// LoadGameState deserializes game state from JSON
+// LoadGameState deserializes game state from JSON, migrating older saves
 func LoadGameState(data []byte) (*GameState, error) {
-	var state GameState
-	err := json.Unmarshal(data, &state)
+	state, _, err := LoadSave(data)
+	return state, err
+}
+
+// LoadSave deserializes game state and reports version and migration details
+func LoadSave(data []byte) (*GameState, SaveInfo, error) {
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, SaveInfo{}, fmt.Errorf("failed to unmarshal game state: %w", err)
+	}
+
+	// Version 1 saves are a bare GameState without an envelope
+	envelope := SaveEnvelope{Version: 1, State: data}
+	if _, hasVersion := probe["version"]; hasVersion {
+		if err := json.Unmarshal(data, &envelope); err != nil {
+			return nil, SaveInfo{}, fmt.Errorf("failed to unmarshal save envelope: %w", err)
+		}
+	}
+
+	info := SaveInfo{Version: envelope.Version}
+	if envelope.Version > CurrentSaveVersion {
+		return nil, info, &SaveMigrationError{
+			FromVersion: envelope.Version,
+			ToVersion:   CurrentSaveVersion,
+			Reason:      "save was written by a newer release",
+		}
+	}
+	if envelope.Version < 1 {
+		return nil, info, &SaveMigrationError{
+			FromVersion: envelope.Version,
+			ToVersion:   CurrentSaveVersion,
+			Reason:      "invalid save version",
+		}
+	}
+
+	doc, err := decodeStateDocument(envelope.State)
+	if err != nil {
+		return nil, info, fmt.Errorf("failed to unmarshal game state: %w", err)
+	}
+
+	// Walk the migration chain one version at a time
+	for version := envelope.Version; version < CurrentSaveVersion; version++ {
+		migrate, exists := saveMigrations[version]
+		if !exists {
+			return nil, info, &SaveMigrationError{
+				FromVersion: version,
+				ToVersion:   version + 1,
+				Reason:      "no migration available",
+			}
+		}
+		if err := migrate(doc); err != nil {
+			return nil, info, &SaveMigrationError{
+				FromVersion: version,
+				ToVersion:   version + 1,
+				Reason:      err.Error(),
+			}
+		}
+		info.Migrated = true
+	}
+
+	migrated, err := json.Marshal(doc)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal game state: %w", err)
+		return nil, info, fmt.Errorf("failed to unmarshal game state: %w", err)
+	}
+	var state GameState
+	if err := json.Unmarshal(migrated, &state); err != nil {
+		return nil, info, fmt.Errorf("failed to unmarshal game state: %w", err)
+	}
+
+	if err := rehydrateEvents(&state); err != nil {
+		return nil, info, err
+	}
+	info.CardDBChanged = envelope.CardDBHash != "" && envelope.CardDBHash != CardDBHash()
+
+	return &state, info, nil
+}
+
+// decodeStateDocument decodes state JSON into a generic document for migrations.
+// Numbers stay json.Number so 64-bit values such as RandSeed survive unchanged.
+func decodeStateDocument(data []byte) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var doc map[string]any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// migrateSaveV1ToV2 drops embedded event effects so they are refreshed from CardDB
+func migrateSaveV1ToV2(doc map[string]any) error {
+	if _, ok := doc["Round"]; !ok {
+		return fmt.Errorf("not a game state (missing Round)")
+	}
+	stripEventEffects(doc)
+	return nil
+}
+
+// migrateSaveV2ToV3 starts the random stream of older saves from their seed
+func migrateSaveV2ToV3(doc map[string]any) error {
+	seed := int64(0)
+	if raw, ok := doc["RandSeed"].(json.Number); ok {
+		var err error
+		if seed, err = raw.Int64(); err != nil {
+			return fmt.Errorf("invalid RandSeed: %w", err)
+		}
+	}
+	doc["RNGState"] = json.Number(strconv.FormatUint(seedRNGState(seed), 10))
+	return nil
+}
+
+// migrateSaveV3ToV4 deals the loaded question bank into older question
+// orders, which listed the 50 built-in questions. Questions already asked
+// stay put; unasked ones missing from the data files are dropped, and ones
+// added since (such as extra packs) follow in ID order.
+func migrateSaveV3ToV4(doc map[string]any) error {
+	if len(QuestionDB) == 0 {
+		return nil // Nothing to deal from (e.g. tools that never load questions)
+	}
+	order, _ := doc["QuestionOrder"].([]any)
+	next := 0
+	if raw, ok := doc["NextQuestion"].(json.Number); ok {
+		n, err := raw.Int64()
+		if err != nil {
+			return fmt.Errorf("invalid NextQuestion: %w", err)
+		}
+		next = min(max(int(n), 0), len(order))
+	}
+
+	migrated := append([]any(nil), order[:next]...)
+	dealt := make(map[int]bool)
+	for i, entry := range order {
+		raw, ok := entry.(json.Number)
+		if !ok {
+			return fmt.Errorf("invalid QuestionOrder entry %v", entry)
+		}
+		id, err := raw.Int64()
+		if err != nil {
+			return fmt.Errorf("invalid QuestionOrder entry: %w", err)
+		}
+		dealt[int(id)] = true
+		if _, exists := QuestionDB[int(id)]; exists && i >= next {
+			migrated = append(migrated, raw)
+		}
+	}
+	for _, id := range sortedQuestionIDs() {
+		if !dealt[id] {
+			migrated = append(migrated, json.Number(strconv.Itoa(id)))
+		}
+	}
+	doc["QuestionOrder"] = migrated
+	return nil
+}
+
+// stripEventEffects reduces each serialized event card to its ID
+func stripEventEffects(doc map[string]any) {
+	events, ok := doc["Events"].([]any)
+	if !ok {
+		return
 	}
-	return &state, nil
-}
\ No newline at end of file
+	for i, event := range events {
+		if fields, ok := event.(map[string]any); ok {
+			events[i] = map[string]any{"ID": fields["ID"]}
+		}
+	}
+}
+
+// rehydrateEvents restores event card names and effects from the loaded CardDB
+func rehydrateEvents(state *GameState) error {
+	if CardDB == nil {
+		return nil // Nothing to rehydrate from (e.g. tools that never load cards)
+	}
+	for i, event := range state.Events {
+		card, exists := CardDB[event.ID]
+		if !exists {
+			return fmt.Errorf("save references unknown event card: %s", event.ID)
+		}
+		state.Events[i] = EventCard{
+			ID:          event.ID,
+			Name:        card.Name,
+			Description: card.Description,
+			Effects:     card.Effects,
+		}
+	}
+	return nil
+}
+
+// CardDBHash fingerprints the loaded card database so saves can detect card changes
+func CardDBHash() string {
+	var buf bytes.Buffer
+	for _, id := range sortedCardIDs() {
+		data, _ := json.Marshal(CardDB[id])
+		buf.Write(data)
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,280 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func withTestCardDB(t *testing.T, cards ...Card) {
+	t.Helper()
+	previous := CardDB
+	CardDB = make(map[CardID]Card)
+	for _, card := range cards {
+		CardDB[CardID(card.ID)] = card
+	}
+	t.Cleanup(func() { CardDB = previous })
+}
+
+func newSerializationTestGameState() GameState {
+	state := newTestGameState()
+	state.Events = []EventCard{
+		{ID: "EVENT_001", Name: "Memory Leak", Effects: []Effect{{Op: ModifyBugs, Scope: AllRooms, N: 1}}},
+	}
+	state.Players["P1"].Kills = map[EnemyType]int{StackOverflow: 2}
+	return state
+}
+
+func TestSaveGameStateWritesVersionedEnvelope(t *testing.T) {
+	withTestCardDB(t, Card{ID: "EVENT_001", Name: "Memory Leak", Source: SrcEvent})
+	state := newSerializationTestGameState()
+
+	data, err := SaveGameState(&state)
+	if err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+
+	var envelope SaveEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		t.Fatalf("save is not an envelope: %v", err)
+	}
+	if envelope.Version != CurrentSaveVersion {
+		t.Errorf("expected version %d, got %d", CurrentSaveVersion, envelope.Version)
+	}
+	if envelope.CardDBHash != CardDBHash() {
+		t.Errorf("expected card hash %s, got %s", CardDBHash(), envelope.CardDBHash)
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(envelope.State, &doc); err != nil {
+		t.Fatal(err)
+	}
+	event := doc["Events"].([]any)[0].(map[string]any)
+	if _, embedded := event["Effects"]; embedded {
+		t.Error("expected event effects to be stored by ID only")
+	}
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	withTestCardDB(t, Card{
+		ID: "EVENT_001", Name: "Memory Leak", Source: SrcEvent,
+		Effects: []Effect{{Op: ModifyBugs, Scope: AllRooms, N: 1}},
+	})
+	state := newSerializationTestGameState()
+
+	data, err := SaveGameState(&state)
+	if err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+	loaded, info, err := LoadSave(data)
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+
+	if info.Migrated || info.CardDBChanged {
+		t.Errorf("expected fresh save to load without migration, got %+v", info)
+	}
+	if loaded.Players["P1"].Location != "R12" || loaded.Players["P1"].Kills[StackOverflow] != 2 {
+		t.Error("player state did not survive round trip")
+	}
+	if len(loaded.Events) != 1 || len(loaded.Events[0].Effects) != 1 {
+		t.Fatalf("expected event effects rehydrated, got %+v", loaded.Events)
+	}
+}
+
+func TestLoadSaveRefreshesStaleEventEffects(t *testing.T) {
+	withTestCardDB(t, Card{
+		ID: "EVENT_001", Name: "Memory Leak", Source: SrcEvent,
+		Effects: []Effect{{Op: ModifyBugs, Scope: AllRooms, N: 1}},
+	})
+	state := newSerializationTestGameState()
+	data, err := SaveGameState(&state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// cards.yaml changed between releases
+	CardDB["EVENT_001"] = Card{
+		ID: "EVENT_001", Name: "Memory Leak v2", Source: SrcEvent,
+		Effects: []Effect{{Op: ModifyBugs, Scope: AllRooms, N: 2}},
+	}
+
+	loaded, info, err := LoadSave(data)
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if !info.CardDBChanged {
+		t.Error("expected card database change to be reported")
+	}
+	if loaded.Events[0].Name != "Memory Leak v2" || loaded.Events[0].Effects[0].N != 2 {
+		t.Errorf("expected event refreshed from current cards, got %+v", loaded.Events[0])
+	}
+}
+
+func TestLoadSaveMigratesBareV1State(t *testing.T) {
+	withTestCardDB(t, Card{
+		ID: "EVENT_001", Name: "Memory Leak", Source: SrcEvent,
+		Effects: []Effect{{Op: ModifyBugs, Scope: AllRooms, N: 3}},
+	})
+	state := newSerializationTestGameState()
+
+	// Version 1 saves were a bare json.MarshalIndent of GameState
+	v1, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, info, err := LoadSave(v1)
+	if err != nil {
+		t.Fatalf("failed to load v1 save: %v", err)
+	}
+	if info.Version != 1 || !info.Migrated {
+		t.Errorf("expected v1 save to be migrated, got %+v", info)
+	}
+	if loaded.Round != state.Round || loaded.Players["P1"].Location != "R12" {
+		t.Error("v1 state did not survive migration")
+	}
+	if loaded.Events[0].Effects[0].N != 3 {
+		t.Error("expected v1 embedded effects replaced by current card effects")
+	}
+}
+
+func TestLoadSaveRejectsFutureVersion(t *testing.T) {
+	data := []byte(`{"version": 999, "card_db_hash": "", "state": {"Round": 1}}`)
+
+	_, err := LoadGameState(data)
+
+	var migrationErr *SaveMigrationError
+	if !errors.As(err, &migrationErr) {
+		t.Fatalf("expected SaveMigrationError, got %v", err)
+	}
+	if migrationErr.FromVersion != 999 {
+		t.Errorf("expected from version 999, got %d", migrationErr.FromVersion)
+	}
+}
+
+func TestLoadSaveReportsFailedMigrationStep(t *testing.T) {
+	_, err := LoadGameState([]byte(`{"NotAGameState": true}`))
+
+	var migrationErr *SaveMigrationError
+	if !errors.As(err, &migrationErr) {
+		t.Fatalf("expected SaveMigrationError, got %v", err)
+	}
+	if migrationErr.FromVersion != 1 || migrationErr.ToVersion != 2 {
+		t.Errorf("expected failure on step 1→2, got %d→%d", migrationErr.FromVersion, migrationErr.ToVersion)
+	}
+}
+
+func TestLoadSaveRejectsUnknownEventCard(t *testing.T) {
+	withTestCardDB(t, Card{ID: "EVENT_001", Source: SrcEvent})
+	state := newSerializationTestGameState()
+	data, err := SaveGameState(&state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	delete(CardDB, "EVENT_001")
+	CardDB["OTHER"] = Card{ID: "OTHER"}
+
+	if _, err := LoadGameState(data); err == nil {
+		t.Error("expected error for save referencing a removed event card")
+	}
+}
+
+func TestSaveLoadPreservesFullRandSeed(t *testing.T) {
+	withTestCardDB(t, Card{ID: "EVENT_001", Source: SrcEvent})
+	state := newSerializationTestGameState()
+	state.RandSeed = 1792214512893394743 // Not representable as float64
+
+	data, err := SaveGameState(&state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadGameState(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.RandSeed != state.RandSeed {
+		t.Errorf("RandSeed changed across save/load: %d → %d", state.RandSeed, loaded.RandSeed)
+	}
+}
+
+func TestLoadSaveMigratesV2SeedsRNGState(t *testing.T) {
+	withTestCardDB(t, Card{ID: "EVENT_001", Source: SrcEvent})
+	state := newSerializationTestGameState()
+
+	// Version 2 saves had no RNGState field
+	stateData, err := json.Marshal(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(stateData, &doc); err != nil {
+		t.Fatal(err)
+	}
+	delete(doc, "RNGState")
+	stripEventEffects(doc)
+	v2State, _ := json.Marshal(doc)
+	v2, _ := json.Marshal(SaveEnvelope{Version: 2, CardDBHash: CardDBHash(), State: v2State})
+
+	loaded, info, err := LoadSave(v2)
+	if err != nil {
+		t.Fatalf("failed to load v2 save: %v", err)
+	}
+	if info.Version != 2 || !info.Migrated {
+		t.Errorf("expected v2 save to be migrated, got %+v", info)
+	}
+	if loaded.RNGState != seedRNGState(state.RandSeed) {
+		t.Errorf("expected RNGState seeded from RandSeed, got %d", loaded.RNGState)
+	}
+}
+
+func TestLoadSaveMigratesV3QuestionOrder(t *testing.T) {
+	withTestCardDB(t, Card{ID: "EVENT_001", Source: SrcEvent})
+	withDifficultyQuestions(t)
+	QuestionDB[60] = Question{ID: 60, Text: "Q", Options: []string{"a", "b"}, Category: "go", Difficulty: Normal}
+	delete(QuestionDB, 3) // Since dropped from the data files
+
+	// Version 3 saves ordered the 50 built-in questions; 4 has been asked
+	v3State := []byte(`{"Round": 2, "RandSeed": 42, "RNGState": 7, "NextQuestion": 1,
+		"QuestionOrder": [4, 3, 0, 2, 1],
+		"Rooms": {}, "Players": {}, "Enemies": {}, "Events": [{"ID": "EVENT_001"}]}`)
+	v3, _ := json.Marshal(SaveEnvelope{Version: 3, CardDBHash: CardDBHash(), State: v3State})
+
+	loaded, info, err := LoadSave(v3)
+	if err != nil {
+		t.Fatalf("failed to load v3 save: %v", err)
+	}
+	if info.Version != 3 || !info.Migrated {
+		t.Errorf("expected v3 save to be migrated, got %+v", info)
+	}
+	if want := []int{4, 2, 1, 60}; !reflect.DeepEqual(loaded.QuestionOrder, want) {
+		t.Errorf("expected question order %v, got %v", want, loaded.QuestionOrder)
+	}
+	if loaded.NextQuestion != 1 || loaded.RNGState != 7 {
+		t.Errorf("expected the rest of the state untouched, got next %d and RNGState %d", loaded.NextQuestion, loaded.RNGState)
+	}
+}
+
+func TestSaveLoadPreservesRNGStream(t *testing.T) {
+	withTestCardDB(t, Card{ID: "EVENT_001", Source: SrcEvent})
+	state := newSerializationTestGameState()
+	state.RNGState = seedRNGState(7)
+	GetGameRNG(&state).Intn(100) // Advance mid-stream
+
+	data, err := SaveGameState(&state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadGameState(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.RNGState != state.RNGState {
+		t.Fatalf("RNGState changed across save/load: %d → %d", state.RNGState, loaded.RNGState)
+	}
+	if GetGameRNG(loaded).Int63() != GetGameRNG(&state).Int63() {
+		t.Error("loaded save should continue the same random stream")
+	}
+}
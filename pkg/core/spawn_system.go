@@ -2,20 +2,11 @@ package core
 
 import (
 	"fmt"
-	"math/rand"
 )
 
-// GetGameRNG creates a consistent RNG for game operations
-func GetGameRNG(state *GameState) *rand.Rand {
-	// Create deterministic RNG from game state
-	// Use Round * 1000 + Time to ensure unique seeds for different game moments
-	seed := state.RandSeed + int64(state.Round*1000) + int64(state.Time)
-	return rand.New(rand.NewSource(seed))
-}
-
 // SpawnEnemyFromBag draws an enemy from the spawn bag and places it in the specified room
 // This is the ONLY way to spawn enemies in the game
-func SpawnEnemyFromBag(state *GameState, roomID RoomID) bool {
+func SpawnEnemyFromBag(state *GameState, roomID RoomID, log *EffectLog) bool {
 	if state.SpawnBag == nil || len(state.SpawnBag.Tokens) == 0 {
 		return false // No enemies left to spawn
 	}
@@ -33,27 +24,19 @@ func SpawnEnemyFromBag(state *GameState, roomID RoomID) bool {
 		state.SpawnBag.Tokens[tokenIndex+1:]...,
 	)
 	
-	// Create the enemy
-	// Validate enemy type
-	if enemyType < InfiniteLoop || enemyType > Pythogoras {
-		return false
-	}
-	
 	// Generate unique enemy ID
 	enemyID := EnemyID(fmt.Sprintf("E%d", len(state.Enemies)+1))
 	
-	// Create enemy with stats from constants
-	stats := ENEMY_STATS[enemyType]
-	enemy := &Enemy{
-		ID:       enemyID,
-		Type:     enemyType,
-		HP:       stats.HP,
-		MaxHP:    stats.HP,
-		Damage:   stats.Damage,
-		Location: roomID,
+	// Create the enemy with the stats from EnemyDB; undefined types spawn nothing
+	enemy, exists := newEnemy(enemyID, enemyType, roomID)
+	if !exists {
+		return false
 	}
 	
 	state.Enemies[enemyID] = enemy
+	
+	log.Add("👹 %s spawned in %s", getEnemyDisplayName(enemyType), roomID)
+	triggerAbilities(state, enemy, OnSpawn, log)
 	return true
 }
 
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -16,7 +17,7 @@ func TestSpawnEnemyFromBag_Success(t *testing.T) {
 	}
 	
 	// Spawn enemy
-	success := SpawnEnemyFromBag(&state, "R01")
+	success := SpawnEnemyFromBag(&state, "R01", NewEffectLog())
 	
 	if !success {
 		t.Error("Expected spawn to succeed")
@@ -49,7 +50,7 @@ func TestSpawnEnemyFromBag_EmptyBag(t *testing.T) {
 	}
 	
 	// Try to spawn (should fail)
-	success := SpawnEnemyFromBag(&state, "R01")
+	success := SpawnEnemyFromBag(&state, "R01", NewEffectLog())
 	
 	if success {
 		t.Error("Expected spawn to fail with empty bag")
@@ -77,7 +78,7 @@ func TestSpawnEnemyFromBag_MultipleRooms(t *testing.T) {
 	// Spawn enemies using only SpawnEnemyFromBag
 	spawned := 0
 	for _, roomID := range roomIDs {
-		if SpawnEnemyFromBag(&state, roomID) {
+		if SpawnEnemyFromBag(&state, roomID, NewEffectLog()) {
 			spawned++
 		}
 		if IsSpawnBagEmpty(&state) {
@@ -167,27 +168,40 @@ func TestIsSpawnBagEmpty(t *testing.T) {
 func TestGetGameRNG_Deterministic(t *testing.T) {
 	state := GameState{
 		RandSeed: 42,
+		RNGState: seedRNGState(42),
 		Round:    1,
 		Time:     5,
 	}
 	
-	// Should get same sequence with same state
-	rng1 := GetGameRNG(&state)
-	val1 := rng1.Intn(100)
-	
-	rng2 := GetGameRNG(&state)
-	val2 := rng2.Intn(100)
+	// Copies of the same state should produce the same sequence
+	copied := deepCopyGameState(state)
+	val1 := GetGameRNG(&state).Intn(1000)
+	val2 := GetGameRNG(&copied).Intn(1000)
 	
 	if val1 != val2 {
 		t.Errorf("RNG should be deterministic: %d != %d", val1, val2)
 	}
 	
-	// Should get different sequence with different state
-	state.Round = 2
-	rng3 := GetGameRNG(&state)
-	val3 := rng3.Intn(100)
+	// Drawing advances the stream stored in the state
+	if state.RNGState == seedRNGState(42) {
+		t.Error("Drawing from the game RNG should advance RNGState")
+	}
 	
-	if val1 == val3 {
-		t.Error("Different game states should produce different RNG sequences")
+	// Successive draws and different seeds give different sequences
+	sequence := func(state *GameState) string {
+		values := make([]int, 5)
+		for i := range values {
+			values[i] = GetGameRNG(state).Intn(1000)
+		}
+		return fmt.Sprint(values)
 	}
-}
\ No newline at end of file
+	stream := GameState{RNGState: seedRNGState(42)}
+	first := sequence(&stream)
+	if first == sequence(&stream) {
+		t.Error("Successive draws should not repeat the same sequence")
+	}
+	other := GameState{RNGState: seedRNGState(43)}
+	if first == sequence(&other) {
+		t.Error("Different seeds should produce different RNG sequences")
+	}
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SprintSteps returns how many rooms a card moves its player, or 0 for cards
+// that do not move them
+func SprintSteps(card Card) int {
+	for _, effect := range card.Effects {
+		if effect.Op == MoveSelf {
+			return effect.N
+		}
+	}
+	return 0
+}
+
+// PlanSprint finds the path a movement card takes a player along to reach a
+// room at most steps rooms away. Sprints go around corrupted rooms and
+// shut doors, and cannot end in a corrupted room. The path starts with the
+// player's own room.
+func PlanSprint(state *GameState, playerID PlayerID, to RoomID, steps int) PathResult {
+	player, exists := state.Players[playerID]
+	if !exists || steps <= 0 || to == player.Location {
+		return PathResult{}
+	}
+	if room := state.Rooms[to]; room == nil || room.Corrupted {
+		return PathResult{}
+	}
+	return CanTraverse(state, PathQuery{
+		From:           player.Location,
+		To:             to,
+		MaxSteps:       steps,
+		OpenOnly:       true,
+		AvoidCorrupted: true,
+	})
+}
+
+// SprintTargets lists the rooms a movement card can take a player to, in
+// room ID order
+func SprintTargets(state *GameState, playerID PlayerID, steps int) []RoomID {
+	var targets []RoomID
+	for _, id := range sortedRoomIDs(state) {
+		if PlanSprint(state, playerID, id, steps).Valid {
+			targets = append(targets, id)
+		}
+	}
+	return targets
+}
+
+// ApplyMoveSelf moves the player along state.Sprint, the path the reducer
+// planned to the room the card was played on
+func ApplyMoveSelf(state *GameState, effect Effect, playerID PlayerID, log *EffectLog) error {
+	player, exists := state.Players[playerID]
+	if !exists {
+		return fmt.Errorf("unknown player %s", playerID)
+	}
+	if len(state.Sprint) == 0 {
+		return fmt.Errorf("no destination room chosen")
+	}
+	if len(state.Sprint) > effect.N {
+		return fmt.Errorf("path of %d rooms is longer than %d", len(state.Sprint), effect.N)
+	}
+
+	log.Add("🏃 %s sprints %s", playerID, formatPath(append([]RoomID{player.Location}, state.Sprint...)))
+	continueSprint(state, player, log)
+	return nil
+}
+
+// continueSprint walks the rest of a sprint. Explored rooms are dashed
+// through; an unexplored room is entered like a normal move, with its coding
+// question and movement consequence, and the sprint waits while the question
+// is pending. resolveQuestion and skipQuestion pick it up again.
+func continueSprint(state *GameState, player *PlayerState, log *EffectLog) {
+	for len(state.Sprint) > 0 {
+		next := state.Sprint[0]
+		state.Sprint = state.Sprint[1:]
+
+		if room := state.Rooms[next]; room != nil && !room.Explored {
+			if askQuestion(state, MoveAction{PlayerID: player.ID, To: next}, log) {
+				return
+			}
+			applyMove(state, player, next, log)
+			continue
+		}
+		log.Add("🏃 %s dashes %s → %s", player.ID, player.Location, next)
+		player.Location = next
+		makeNoise(state, next, ActionNoise.Move)
+	}
+	state.Sprint = nil
+}
+
+// stopSprint ends a sprint early in the player's current room
+func stopSprint(state *GameState, player *PlayerState, log *EffectLog) {
+	if len(state.Sprint) > 0 {
+		log.Add("🛑 %s's sprint stops in %s", player.ID, player.Location)
+	}
+	state.Sprint = nil
+}
+
+// formatPath renders a path as "R12 → R07 → R02"
+func formatPath(path []RoomID) string {
+	names := make([]string, len(path))
+	for i, id := range path {
+		names[i] = string(id)
+	}
+	return strings.Join(names, " → ")
+}
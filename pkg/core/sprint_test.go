@@ -0,0 +1,150 @@
+package core
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newSprintGame starts a game on corruptionMap with P1 in C holding a card
+// that moves up to 3 rooms. A, B, the key room D and the engine room E are
+// unexplored; B is not corrupted.
+//
+//	A B C
+//	D E F
+func newSprintGame(t *testing.T) GameState {
+	t.Helper()
+	withTestCardDB(t, Card{ID: "SPRINT", Name: "Sprint", Source: SrcAction, Effects: []Effect{{Op: MoveSelf, Scope: Self, N: 3}}})
+	withTestQuestions(t)
+	withTestMap(t, corruptionMap)
+	state := Apply(GameState{}, InitializeGameAction{Seed: 1, PlayerClasses: []DevClass{Backend}}, NewEffectLog())
+	state.ActivePlayer = "P1"
+	state.ActionsLeft = 2
+	state.QuestionOrder = []int{3, 0}
+	state.NextQuestion = 0
+	state.Players["P1"].Hand = []CardID{"SPRINT"}
+	return state
+}
+
+// answerPending answers the pending choice question right or wrong
+func answerPending(t *testing.T, state GameState, correct bool) GameState {
+	t.Helper()
+	question, pending := CurrentQuestion(&state)
+	if !pending || question.Kind != ChoiceQuestion {
+		t.Fatalf("expected a pending choice question, got %+v", state.PendingQuestion)
+	}
+	return Apply(state, scriptedAnswer(question, "P1", correct), NewEffectLog())
+}
+
+func TestSprintDashesThroughExploredRooms(t *testing.T) {
+	state := newSprintGame(t)
+	for _, room := range state.Rooms {
+		room.Explored = true
+	}
+	bugs := state.Rooms["E"].BugMarkers + state.Rooms["F"].BugMarkers + state.Rooms["C"].BugMarkers
+
+	log := NewEffectLog()
+	state = Apply(state, PlayCardAction{PlayerID: "P1", CardID: "SPRINT", Target: "D"}, log)
+	if loc := state.Players["P1"].Location; loc != "D" {
+		t.Fatalf("expected P1 to sprint to D, got %s", loc)
+	}
+	if state.PendingQuestion != nil || state.Sprint != nil {
+		t.Errorf("expected no question and no sprint left, got %+v and %v", state.PendingQuestion, state.Sprint)
+	}
+	if got := state.Rooms["E"].BugMarkers + state.Rooms["F"].BugMarkers + state.Rooms["C"].BugMarkers; got != bugs {
+		t.Errorf("expected explored rooms crossed without movement consequences, bugs %d → %d", bugs, got)
+	}
+	if !strings.Contains(strings.Join(log.Lines, "\n"), "🏃 P1 sprints C → ") {
+		t.Errorf("expected the path to be logged, got %v", log.Lines)
+	}
+}
+
+func TestSprintAsksQuestionPerUnexploredRoom(t *testing.T) {
+	play := PlayCardAction{PlayerID: "P1", CardID: "SPRINT", Target: "A"}
+
+	// Each unexplored room waits on its own question
+	state := Apply(newSprintGame(t), play, NewEffectLog())
+	if state.PendingQuestion == nil || state.PendingQuestion.To != "B" || !reflect.DeepEqual(state.Sprint, []RoomID{"A"}) {
+		t.Fatalf("expected a question for B with A still to go, got %+v and %v", state.PendingQuestion, state.Sprint)
+	}
+	if err := ValidateAction(&state, MoveAction{PlayerID: "P1", To: "B"}); !errors.Is(err, ErrQuestionPending) {
+		t.Errorf("expected other actions blocked mid-sprint, got %v", err)
+	}
+	state = answerPending(t, state, true)
+	if state.Players["P1"].Location != "B" || state.PendingQuestion == nil || state.PendingQuestion.To != "A" {
+		t.Fatalf("expected P1 in B facing A's question, got %s with %+v", state.Players["P1"].Location, state.PendingQuestion)
+	}
+	state = answerPending(t, state, true)
+	if state.Players["P1"].Location != "A" || !state.Rooms["A"].Explored || state.Sprint != nil {
+		t.Errorf("expected P1 to finish the sprint in explored A, got %s with %v", state.Players["P1"].Location, state.Sprint)
+	}
+
+	// A wrong answer ends the sprint in that room
+	state = Apply(newSprintGame(t), play, NewEffectLog())
+	state = answerPending(t, state, false)
+	if state.Players["P1"].Location != "B" || state.PendingQuestion != nil || state.Sprint != nil {
+		t.Errorf("expected the sprint to stop in B, got %s with %+v and %v", state.Players["P1"].Location, state.PendingQuestion, state.Sprint)
+	}
+
+	// A question skip keeps it going
+	state = newSprintGame(t)
+	state.Players["P1"].QuestionSkips = 1
+	state = Apply(state, play, NewEffectLog())
+	state = Apply(state, SkipQuestionAction{PlayerID: "P1"}, NewEffectLog())
+	if state.Players["P1"].Location != "B" || state.PendingQuestion == nil || state.PendingQuestion.To != "A" {
+		t.Errorf("expected the skip to carry P1 on to A's question, got %s with %+v", state.Players["P1"].Location, state.PendingQuestion)
+	}
+}
+
+func TestSprintValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  RoomID
+		corrupt RoomID
+		want    error
+	}{
+		{"no destination", "", "", ErrNoDestination},
+		{"unknown room", "Z", "", ErrUnknownRoom},
+		{"own room", "C", "", ErrOutOfReach},
+		{"corrupted destination", "B", "B", ErrOutOfReach},
+		{"too far around corruption", "A", "B", ErrOutOfReach},
+		{"around corruption", "D", "B", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := newSprintGame(t)
+			if tt.corrupt != "" {
+				state.Rooms[tt.corrupt].Corrupted = true
+			}
+			err := ValidateAction(&state, PlayCardAction{PlayerID: "P1", CardID: "SPRINT", Target: tt.target})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestSprintLegalActions(t *testing.T) {
+	state := newSprintGame(t)
+	state.Rooms["B"].Corrupted = true
+
+	var targets []RoomID
+	questions := make(map[RoomID]bool)
+	for _, option := range LegalActions(&state) {
+		if play, ok := option.Action.(PlayCardAction); ok {
+			if !option.Legal() || option.Cost != 1 {
+				t.Errorf("expected a legal 1-action sprint to %s, got %+v", play.Target, option)
+			}
+			targets = append(targets, play.Target)
+			questions[play.Target] = option.NeedsQuestion
+		}
+	}
+	if !reflect.DeepEqual(targets, []RoomID{"D", "E", "F"}) {
+		t.Errorf("expected sprints to D, E and F around corrupted B, got %v", targets)
+	}
+	// The key and engine rooms D and E still ask their question
+	if want := map[RoomID]bool{"D": true, "E": true, "F": false}; !reflect.DeepEqual(questions, want) {
+		t.Errorf("expected questions only on the way into D and E, got %v", questions)
+	}
+}
@@ -0,0 +1,234 @@
+package core
+
+import (
+	"testing"
+)
+
+func newHotSeatTestGameState() GameState {
+	return GameState{
+		Round:        1,
+		Time:         15,
+		RandSeed:     42,
+		ActivePlayer: "P1",
+		Rooms: map[RoomID]*RoomState{
+			"R12": {ID: "R12", Type: Predefined},
+		},
+		Players: map[PlayerID]*PlayerState{
+			"P1": {ID: "P1", Location: "R12", HP: 5, MaxHP: 5, Deck: []CardID{"C1", "C2", "C3", "C4", "C5"}},
+			"P2": {ID: "P2", Location: "R12", HP: 3, MaxHP: 3, Deck: []CardID{"C6", "C7", "C8", "C9", "C10"}},
+			"P3": {ID: "P3", Location: "R12", HP: 4, MaxHP: 4, Deck: []CardID{"C11", "C12", "C13", "C14", "C15"}},
+		},
+		Enemies: map[EnemyID]*Enemy{},
+	}
+}
+
+func TestInitializeGameActionCreatesOnePlayerPerClass(t *testing.T) {
+	action := InitializeGameAction{
+		Seed:          42,
+		PlayerClasses: []DevClass{Frontend, Backend, DevOps},
+	}
+
+	state := ApplyWithoutLog(GameState{}, action)
+
+	if len(state.Players) != 3 {
+		t.Fatalf("expected 3 players, got %d", len(state.Players))
+	}
+	expected := map[PlayerID]DevClass{"P1": Frontend, "P2": Backend, "P3": DevOps}
+	for playerID, class := range expected {
+		player := state.Players[playerID]
+		if player == nil {
+			t.Fatalf("expected player %s to exist", playerID)
+		}
+		if player.Class != class {
+			t.Errorf("expected %s to be class %v, got %v", playerID, class, player.Class)
+		}
+		if player.HP != CLASS_STATS[class].HP {
+			t.Errorf("expected %s HP %d, got %d", playerID, CLASS_STATS[class].HP, player.HP)
+		}
+		if player.Location != "R12" {
+			t.Errorf("expected %s to start in R12, got %s", playerID, player.Location)
+		}
+	}
+	if state.ActivePlayer != "P1" {
+		t.Errorf("expected P1 to open the game, got %s", state.ActivePlayer)
+	}
+}
+
+func TestInitializeGameActionFallsBackToSoloClass(t *testing.T) {
+	state := ApplyWithoutLog(GameState{}, InitializeGameAction{Seed: 42, PlayerClass: Backend})
+
+	if len(state.Players) != 1 {
+		t.Fatalf("expected 1 player, got %d", len(state.Players))
+	}
+	if state.Players["P1"].Class != Backend {
+		t.Errorf("expected P1 to be Backend, got %v", state.Players["P1"].Class)
+	}
+}
+
+func TestInitializeGameActionCapsPlayerCount(t *testing.T) {
+	classes := []DevClass{Frontend, Backend, DevOps, Fullstack, Frontend}
+	state := ApplyWithoutLog(GameState{}, InitializeGameAction{Seed: 42, PlayerClasses: classes})
+
+	if len(state.Players) != MaxPlayers {
+		t.Errorf("expected player count capped at %d, got %d", MaxPlayers, len(state.Players))
+	}
+}
+
+func TestDrawPhaseDrawsForEveryPlayer(t *testing.T) {
+	gs := newHotSeatTestGameState()
+
+	DrawPhase(&gs)
+
+	for playerID, player := range gs.Players {
+		if len(player.Hand) != 5 {
+			t.Errorf("expected %s to hold 5 cards on round 1, got %d", playerID, len(player.Hand))
+		}
+	}
+	if gs.ActivePlayer != "P1" {
+		t.Errorf("expected P1 to act first, got %s", gs.ActivePlayer)
+	}
+	if gs.ActionsLeft != ActionsPerTurn {
+		t.Errorf("expected %d actions, got %d", ActionsPerTurn, gs.ActionsLeft)
+	}
+}
+
+func TestAdvanceTurnRotatesThroughPlayers(t *testing.T) {
+	gs := newHotSeatTestGameState()
+	DrawPhase(&gs)
+
+	gs.ActionsLeft = 0 // P1 spent both actions
+	if !AdvanceTurn(&gs) {
+		t.Fatal("expected P2 to get a turn")
+	}
+	if gs.ActivePlayer != "P2" {
+		t.Errorf("expected P2 active, got %s", gs.ActivePlayer)
+	}
+	if gs.ActionsLeft != ActionsPerTurn {
+		t.Errorf("expected P2 to get %d actions, got %d", ActionsPerTurn, gs.ActionsLeft)
+	}
+	if !gs.Players["P1"].HasActed {
+		t.Error("expected P1 to be marked as having acted")
+	}
+
+	if !AdvanceTurn(&gs) || gs.ActivePlayer != "P3" {
+		t.Fatalf("expected P3 to get a turn, active is %s", gs.ActivePlayer)
+	}
+
+	if AdvanceTurn(&gs) {
+		t.Error("expected round to end after P3")
+	}
+	if gs.ActionsLeft != 0 {
+		t.Errorf("expected no actions left after final turn, got %d", gs.ActionsLeft)
+	}
+}
+
+func TestAdvanceTurnSkipsDeadPlayers(t *testing.T) {
+	gs := newHotSeatTestGameState()
+	gs.Players["P2"].HP = 0
+	DrawPhase(&gs)
+
+	if !AdvanceTurn(&gs) {
+		t.Fatal("expected P3 to get a turn")
+	}
+	if gs.ActivePlayer != "P3" {
+		t.Errorf("expected dead P2 to be skipped, got %s", gs.ActivePlayer)
+	}
+}
+
+func TestDrawPhaseStartsWithFirstLivingPlayer(t *testing.T) {
+	gs := newHotSeatTestGameState()
+	gs.Players["P1"].HP = 0
+
+	DrawPhase(&gs)
+
+	if gs.ActivePlayer != "P2" {
+		t.Errorf("expected P2 to open the round when P1 is dead, got %s", gs.ActivePlayer)
+	}
+}
+
+func TestNewRoundResetsRotation(t *testing.T) {
+	gs := newHotSeatTestGameState()
+	DrawPhase(&gs)
+	for AdvanceTurn(&gs) {
+	}
+
+	EndRoundMaintenance(&gs)
+	DrawPhase(&gs)
+
+	if gs.ActivePlayer != "P1" {
+		t.Errorf("expected P1 to open round 2, got %s", gs.ActivePlayer)
+	}
+	for playerID, player := range gs.Players {
+		if player.HasActed {
+			t.Errorf("expected %s HasActed reset for new round", playerID)
+		}
+	}
+}
+
+func TestCheckEndMultiplayer(t *testing.T) {
+	tests := []struct {
+		name      string
+		setup     func(*GameState)
+		wantEnded bool
+		wantWin   bool
+	}{
+		{
+			name:      "game continues while anyone is alive",
+			setup:     func(s *GameState) { s.Players["P1"].HP = 0 },
+			wantEnded: false,
+		},
+		{
+			name: "team loses when everyone is dead",
+			setup: func(s *GameState) {
+				for _, player := range s.Players {
+					player.HP = 0
+				}
+			},
+			wantEnded: true,
+			wantWin:   false,
+		},
+		{
+			name:      "team loses when time runs out",
+			setup:     func(s *GameState) { s.Time = 0 },
+			wantEnded: true,
+			wantWin:   false,
+		},
+		{
+			name:      "team wins when any player activates the engine",
+			setup:     func(s *GameState) { s.Players["P3"].EngineUsed = true },
+			wantEnded: true,
+			wantWin:   true,
+		},
+		{
+			name: "engine activation wins even if the activator later died",
+			setup: func(s *GameState) {
+				s.Players["P2"].EngineUsed = true
+				s.Players["P2"].HP = 0
+			},
+			wantEnded: true,
+			wantWin:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := newHotSeatTestGameState()
+			tt.setup(&gs)
+
+			ended, win := CheckEndMultiplayer(&gs)
+			if ended != tt.wantEnded || win != tt.wantWin {
+				t.Errorf("expected (ended=%v, win=%v), got (ended=%v, win=%v)", tt.wantEnded, tt.wantWin, ended, win)
+			}
+		})
+	}
+}
+
+func TestCheckEndUsesSoloRulesForOnePlayer(t *testing.T) {
+	gs := newWinTestGameState()
+	gs.Players["P1"].HP = 0
+
+	ended, win := CheckEnd(&gs)
+	if !ended || win {
+		t.Errorf("expected solo loss, got ended=%v win=%v", ended, win)
+	}
+}
@@ -4,6 +4,8 @@ type GameState struct {
 	Round      int
 	Time       int
 	RandSeed   int64
+	RNGState   uint64 // Authoritative random stream, advanced by GetGameRNG
+	Difficulty Difficulty
 	EventIndex uint8
 	
 	// Turn controller fields
@@ -16,10 +18,31 @@ type GameState struct {
 	Events        []EventCard
 	SpawnBag      *SpawnBag
 	Enemies       map[EnemyID]*Enemy
+	// Map the game is played on (see LoadMap); empty in saves from before maps
+	// were data files, which were all played on the default map
+	Map string `json:",omitempty"`
+	// Doors that are not open (see DoorState); omitted when every door is open
+	Doors map[Edge]DoorState `json:",omitempty"`
+	// Noise players made in each room since enemies last activated (see
+	// ActionNoise); omitted when the ship is quiet
+	Noise map[RoomID]int `json:",omitempty"`
+	
 	// Question system using pre-shuffle approach
-	QuestionOrder []int // Pre-shuffled order of question IDs 0-49
+	QuestionOrder []int // Pre-shuffled order of question IDs, reordered by ScheduleQuestions
 	NextQuestion  int   // Index of next question to use
 	
+	// Move into an unexplored room waiting on AnswerQuestionAction; nil otherwise.
+	// Omitted when empty so states without one keep their journal checkpoints.
+	PendingQuestion *PendingQuestion `json:",omitempty"`
+	
+	// Rooms a movement card still has to take the active player through,
+	// kept while the sprint waits on a question; nil otherwise
+	Sprint []RoomID `json:",omitempty"`
+	
+	// Every question answered this game, for learning profiles. Omitted when
+	// empty for the same reason as PendingQuestion.
+	AnswerHistory []AnsweredQuestion `json:",omitempty"`
+	
 	// Effect logging for step-by-step display (not serialized)
 	ScratchLog *EffectLog `json:"-"`
 }
@@ -51,6 +74,11 @@ type PlayerState struct {
 	EngineUsed   bool
 	PersonalObj  ObjectiveID
 	CorporateObj ObjectiveID
+	Kills        map[EnemyType]int // Enemies killed by this player, for objectives
+	
+	// Questions the player may bypass with SkipQuestionAction, granted by
+	// cards. Omitted when zero so older journal checkpoints still match.
+	QuestionSkips int `json:",omitempty"`
 }
 
 type Enemy struct {
@@ -60,6 +88,7 @@ type Enemy struct {
 	MaxHP    uint8
 	Damage   uint8
 	Location RoomID
+	Rounds   uint8 `json:",omitempty"` // Round ends lived through, for abilities
 }
 
 type RoomID string
@@ -76,6 +105,16 @@ const (
 	Fullstack
 )
 
+// Difficulty selects the enemy spawn bag composition; "" is treated as Normal
+type Difficulty string
+const (
+	Easy   Difficulty = "easy"
+	Normal Difficulty = "normal"
+	Hard   Difficulty = "hard"
+)
+
+// EnemyType numbers an enemy type; data/enemies.yaml defines the stats and
+// behaviour of these built-in types and may add more
 type EnemyType int
 const (
 	InfiniteLoop EnemyType = iota
@@ -119,9 +158,37 @@ type EventCard struct {
 // Card reference - actual cards live in pkg/cards
 // Players hold CardIDs, cards are resolved when played
 
+// PendingQuestion is a move into an unexplored room that waits for the mover
+// to answer a coding question with AnswerQuestionAction
+type PendingQuestion struct {
+	PlayerID   PlayerID
+	To         RoomID
+	QuestionID int
+	// Asked at the door of a corrupted room (see QuestionTax): a right answer
+	// lets the player in without a reward, a wrong one keeps them out
+	Corrupted bool `json:",omitempty"`
+}
+
+// QuestionKind is how a question is answered; "" is treated as ChoiceQuestion
+type QuestionKind string
+
+const (
+	ChoiceQuestion QuestionKind = "choice" // Pick the one correct option
+	MultiQuestion  QuestionKind = "multi"  // Pick every correct option
+	TextQuestion   QuestionKind = "text"   // Type a short answer
+)
+
+// Question is a coding question from the question bank (see LoadQuestions)
 type Question struct {
-	ID            int
-	Text          string
-	Options       [4]string
-	CorrectAnswer int
+	ID             int
+	Kind           QuestionKind
+	Text           string
+	Code           string // Optional multi-line snippet shown below Text
+	Options        []string
+	CorrectAnswer  int      // Choice questions
+	CorrectAnswers []int    // Multi questions, ascending
+	Accepted       []string // Text questions; compared after NormalizeAnswer
+	Category       string   // Free-form tag such as "go", "algorithms", "web" or "databases"
+	Difficulty     Difficulty
+	Explanation    string // Shown after the question is answered
 }
\ No newline at end of file
@@ -2,8 +2,51 @@ package core
 
 import (
 	"math/rand"
+	"sort"
 )
 
+// sortedRoomIDs returns every room ID in canonical order so seeded code never
+// depends on map iteration order
+func sortedRoomIDs(state *GameState) []RoomID {
+	ids := make([]RoomID, 0, len(state.Rooms))
+	for id := range state.Rooms {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// sortedEnemyIDs returns every enemy ID in canonical order
+func sortedEnemyIDs(state *GameState) []EnemyID {
+	ids := make([]EnemyID, 0, len(state.Enemies))
+	for id := range state.Enemies {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// sortedCardIDs returns every loaded card ID in canonical order
+func sortedCardIDs() []CardID {
+	ids := make([]CardID, 0, len(CardDB))
+	for id := range CardDB {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// cardIDsBySource returns the IDs of loaded cards from one source in canonical order
+func cardIDsBySource(source EffectSource) []CardID {
+	ids := make([]CardID, 0)
+	for _, id := range sortedCardIDs() {
+		if CardDB[id].Source == source {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
 // moveCards removes `count` cards starting at `start` from src,
 // appends them to dst, and returns the removed slice.
 // This handles duplicates correctly by working with indices, not values.
@@ -80,16 +123,7 @@ func shuffleRoomTypes(roomTypes []RoomType, rng *rand.Rand) {
 
 // getEnemyDisplayName returns the display name for an enemy type
 func getEnemyDisplayName(enemyType EnemyType) string {
-	switch enemyType {
-	case InfiniteLoop:
-		return "Infinite Loop"
-	case StackOverflow:
-		return "Stack Overflow"
-	case Pythogoras:
-		return "Pythogoras"
-	default:
-		return "Enemy"
-	}
+	return enemyDef(enemyType).Name
 }
 
 // getEffectOpName returns the readable name for an effect operation
@@ -117,6 +151,16 @@ func getEffectOpName(op EffectOp) string {
 		return "SpawnEnemy"
 	case MoveEnemies:
 		return "MoveEnemies"
+	case SkipQuestion:
+		return "SkipQuestion"
+	case CloseDoors:
+		return "CloseDoors"
+	case LockDoors:
+		return "LockDoors"
+	case BreakDoors:
+		return "BreakDoors"
+	case MoveSelf:
+		return "MoveSelf"
 	default:
 		return "Unknown"
 	}
@@ -164,6 +208,10 @@ func selectRoomWithTieBreaking(state *GameState, candidates []RoomID) RoomID {
 		return candidates[0]
 	}
 	
+	// Equal distances resolve to the lowest room ID
+	candidates = append([]RoomID(nil), candidates...)
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+	
 	// Use active player's current position as anchor for tie-breaking
 	anchor := state.Players[state.ActivePlayer].Location
 	